@@ -0,0 +1,127 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `SHOW PUMP STATUS` and `SHOW DRAINER STATUS`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Subsystem.
+	binlog = "binlog"
+	// Queries.
+	binlogNowQuery     = `SELECT UNIX_TIMESTAMP(NOW(6))`
+	pumpStatusQuery    = `SHOW PUMP STATUS`
+	drainerStatusQuery = `SHOW DRAINER STATUS`
+)
+
+// States a pump or drainer can be in, exported as an enum.
+var binlogNodeStates = []string{"online", "pausing", "paused", "closing", "offline"}
+
+// Metric descriptors.
+var (
+	binlogNodeStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, binlog, "node_state"),
+		"The state of a pump or drainer, 1 for the current state and 0 for the others.",
+		[]string{"kind", "node_id", "address", "state"}, nil,
+	)
+	binlogMaxCommitTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, binlog, "max_commit_timestamp_seconds"),
+		"The time of the latest commit a pump has written or a drainer has replicated.",
+		[]string{"kind", "node_id", "address"}, nil,
+	)
+	binlogLagDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, binlog, "lag_seconds"),
+		"The time the latest commit of a pump or drainer is behind the time of the server.",
+		[]string{"kind", "node_id", "address"}, nil,
+	)
+)
+
+// ScrapeBinlogStatus collects from `SHOW PUMP STATUS` and `SHOW DRAINER STATUS`.
+type ScrapeBinlogStatus struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeBinlogStatus) Name() string {
+	return "binlog_status"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeBinlogStatus) Help() string {
+	return "Collect the state and replication lag of TiDB Binlog pumps and drainers from SHOW PUMP STATUS and SHOW DRAINER STATUS"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeBinlogStatus) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeBinlogStatus) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	var now float64
+	if err := db.QueryRowContext(ctx, binlogNowQuery).Scan(&now); err != nil {
+		return err
+	}
+	if err := scrapeBinlogNodes(ctx, db, ch, pumpStatusQuery, "pump", now); err != nil {
+		return err
+	}
+	return scrapeBinlogNodes(ctx, db, ch, drainerStatusQuery, "drainer", now)
+}
+
+func scrapeBinlogNodes(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, query, kind string, now float64) error {
+	nodeRows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer nodeRows.Close()
+
+	var (
+		nodeID      string
+		address     string
+		state       string
+		maxCommitTS uint64
+		updateTime  string
+	)
+	for nodeRows.Next() {
+		if err := nodeRows.Scan(&nodeID, &address, &state, &maxCommitTS, &updateTime); err != nil {
+			return err
+		}
+
+		known := false
+		for _, s := range binlogNodeStates {
+			value := 0.0
+			if s == state {
+				value, known = 1, true
+			}
+			ch <- prometheus.MustNewConstMetric(binlogNodeStateDesc, prometheus.GaugeValue, value, kind, nodeID, address, s)
+		}
+		if !known {
+			ch <- prometheus.MustNewConstMetric(binlogNodeStateDesc, prometheus.GaugeValue, 1, kind, nodeID, address, state)
+		}
+
+		// The commit ts is a TSO, its physical part is in milliseconds.
+		commitTime := float64(maxCommitTS>>18) / 1e3
+		ch <- prometheus.MustNewConstMetric(binlogMaxCommitTimeDesc, prometheus.GaugeValue, commitTime, kind, nodeID, address)
+		ch <- prometheus.MustNewConstMetric(binlogLagDesc, prometheus.GaugeValue, now-commitTime, kind, nodeID, address)
+	}
+	return nodeRows.Err()
+}
+
+// check interface
+var _ Scraper = ScrapeBinlogStatus{}
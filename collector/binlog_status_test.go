@@ -0,0 +1,92 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeBinlogStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"NodeID", "Address", "State", "Max_Commit_Ts", "Update_Time"}
+	mock.ExpectQuery(sanitizeQuery(binlogNowQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"UNIX_TIMESTAMP(NOW(6))"}).AddRow(1792116000))
+	// The commit TSOs are 2.5 seconds and an hour before the time of the server.
+	mock.ExpectQuery(sanitizeQuery(pumpStatusQuery)).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow("pump-0:8250", "pump-0:8250", "online", uint64(469792456048640000), "2026-10-16 09:59:59"))
+	mock.ExpectQuery(sanitizeQuery(drainerStatusQuery)).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow("drainer-0:8249", "drainer-0:8249", "paused", uint64(469791512985600000), "2026-10-16 09:00:00"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeBinlogStatus{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	pump := labelMap{"kind": "pump", "node_id": "pump-0:8250", "address": "pump-0:8250"}
+	drainer := labelMap{"kind": "drainer", "node_id": "drainer-0:8249", "address": "drainer-0:8249"}
+	expected := []MetricResult{}
+	for _, node := range []struct {
+		labels     labelMap
+		state      string
+		commitTime float64
+		lag        float64
+	}{
+		{pump, "online", 1792115997.5, 2.5},
+		{drainer, "paused", 1792112400, 3600},
+	} {
+		for _, s := range binlogNodeStates {
+			labels := labelMap{"state": s}
+			for k, v := range node.labels {
+				labels[k] = v
+			}
+			value := 0.0
+			if s == node.state {
+				value = 1
+			}
+			expected = append(expected, MetricResult{labels: labels, value: value, metricType: dto.MetricType_GAUGE})
+		}
+		expected = append(expected,
+			MetricResult{labels: node.labels, value: node.commitTime, metricType: dto.MetricType_GAUGE},
+			MetricResult{labels: node.labels, value: node.lag, metricType: dto.MetricType_GAUGE},
+		)
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
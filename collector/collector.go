@@ -29,6 +29,11 @@ const (
 	namespace = "tidb"
 	// Math constant for picoseconds to seconds.
 	picoSeconds = 1e12
+	// Math constant for nanoseconds to seconds.
+	nanoSeconds = 1e9
+	// Layout of the times TiDB keeps in mysql.tidb, such as "20260101-10:00:00.000 +0800".
+	// Older versions append the zone name, such as "20260101-10:00:00.000 +0800 CST".
+	tidbTimeLayout = "20060102-15:04:05 -0700"
 	// Query to check whether user/table/client stats are enabled.
 	userstatCheckQuery = `SHOW GLOBAL VARIABLES WHERE Variable_Name='userstat'
 		OR Variable_Name='userstat_running'`
@@ -70,6 +75,9 @@ func parseStatus(data sql.RawBytes) (float64, bool) {
 	if ts, err := time.Parse("2006-01-02 15:04:05", string(data)); err == nil {
 		return float64(ts.Unix()), true
 	}
+	if ts, err := parseTiDBGCTime(string(data)); err == nil {
+		return float64(ts.UnixNano()) / nanoSeconds, true
+	}
 	if logNum := logRE.Find(data); logNum != nil {
 		value, err := strconv.ParseFloat(string(logNum), 64)
 		return value, err == nil
@@ -78,6 +86,19 @@ func parseStatus(data sql.RawBytes) (float64, bool) {
 	return value, err == nil
 }
 
+// parseTiDBGCTime parses a time kept in mysql.tidb, with or without a trailing zone name.
+func parseTiDBGCTime(value string) (time.Time, error) {
+	ts, err := time.Parse(tidbTimeLayout, value)
+	if err != nil {
+		if i := strings.LastIndex(value, " "); i > 0 {
+			if ts, err := time.Parse(tidbTimeLayout, value[:i]); err == nil {
+				return ts, nil
+			}
+		}
+	}
+	return ts, err
+}
+
 func parsePrivilege(data sql.RawBytes) (float64, bool) {
 	if bytes.Equal(data, []byte("Y")) {
 		return 1, true
@@ -87,3 +108,21 @@ func parsePrivilege(data sql.RawBytes) (float64, bool) {
 	}
 	return -1, false
 }
+
+// scanColumns scans the current row into a map by upper case column name, NULL values are empty.
+// It is used for SHOW statements whose columns differ between versions.
+func scanColumns(rows *sql.Rows, columns []string) (map[string]string, error) {
+	values := make([]sql.NullString, len(columns))
+	scanArgs := make([]interface{}, len(columns))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+	if err := rows.Scan(scanArgs...); err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(columns))
+	for i, column := range columns {
+		row[strings.ToUpper(column)] = values[i].String
+	}
+	return row, nil
+}
@@ -41,6 +41,9 @@ func readMetric(m prometheus.Metric) MetricResult {
 	if pb.Counter != nil {
 		return MetricResult{labels: labels, value: pb.GetCounter().GetValue(), metricType: dto.MetricType_COUNTER}
 	}
+	if pb.Histogram != nil {
+		return MetricResult{labels: labels, value: float64(pb.GetHistogram().GetSampleCount()), metricType: dto.MetricType_HISTOGRAM}
+	}
 	if pb.Untyped != nil {
 		return MetricResult{labels: labels, value: pb.GetUntyped().GetValue(), metricType: dto.MetricType_UNTYPED}
 	}
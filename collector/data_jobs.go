@@ -0,0 +1,265 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `SHOW BACKUPS`, `SHOW RESTORES` and `SHOW IMPORT JOBS`.
+
+package collector
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	// Subsystem.
+	dataJobs = "data_jobs"
+	// Queries.
+	dataJobsNowQuery    = `SELECT NOW()`
+	showBackupsQuery    = `SHOW BACKUPS`
+	showRestoresQuery   = `SHOW RESTORES`
+	showImportJobsQuery = `SHOW IMPORT JOBS`
+)
+
+// Tunable flags.
+var (
+	dataJobsWindow = kingpin.Flag(
+		"collect.data_jobs.window",
+		"Export the state and progress of jobs which are running or ended within this many seconds",
+	).Default("86400").Int()
+)
+
+// dataJobSource is a statement listing jobs, with the names of its columns.
+type dataJobSource struct {
+	jobType     string
+	query       string
+	id          string
+	destination string
+	state       string
+	progress    string
+	start       string
+	end         string
+	bytes       string
+	rows        string
+}
+
+// Columns which are missing in a version are not exported.
+var dataJobSources = []dataJobSource{
+	{jobType: "backup", query: showBackupsQuery, id: "ID_", destination: "DESTINATION", state: "STATE",
+		progress: "PROGRESS", start: "EXECUTION_TIME", end: "FINISH_TIME"},
+	{jobType: "restore", query: showRestoresQuery, id: "ID_", destination: "DESTINATION", state: "STATE",
+		progress: "PROGRESS", start: "EXECUTION_TIME", end: "FINISH_TIME"},
+	{jobType: "import", query: showImportJobsQuery, id: "JOB_ID", destination: "DATA_SOURCE", state: "STATUS",
+		progress: "CUR_STEP_PROGRESS_PCT", start: "START_TIME", end: "END_TIME", bytes: "CUR_STEP_PROCESSED_SIZE", rows: "IMPORTED_ROWS"},
+}
+
+// Metric descriptors.
+var (
+	dataJobInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, dataJobs, "info"),
+		"The state and destination of a backup, restore or import job, the value is always 1.",
+		[]string{"type", "id", "state", "destination_kind", "destination_hash"}, nil)
+	dataJobProgressDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, dataJobs, "progress_percent"),
+		"The progress of a backup, restore or import job, between 0 and 100.",
+		[]string{"type", "id"}, nil)
+	dataJobBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, dataJobs, "processed_bytes"),
+		"The number of bytes processed by a job, by its current step for an import job.",
+		[]string{"type", "id"}, nil)
+	dataJobRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, dataJobs, "processed_rows"),
+		"The number of rows processed by a job.",
+		[]string{"type", "id"}, nil)
+	dataJobElapsedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, dataJobs, "elapsed_seconds"),
+		"The time a job has been running, or ran for when it has ended.",
+		[]string{"type", "id"}, nil)
+)
+
+// dataJobsState is kept between scrapes of a target.
+type dataJobsState struct {
+	mu sync.Mutex
+	// Job types which have been listed once, their jobs failed before are not counted.
+	initialized map[string]bool
+	// Failed jobs which have been counted and are still listed.
+	failed map[string]bool
+
+	failures *prometheus.CounterVec
+}
+
+func newDataJobsState() interface{} {
+	state := &dataJobsState{
+		initialized: make(map[string]bool),
+		failed:      make(map[string]bool),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dataJobs,
+			Name:      "failed_total",
+			Help:      "The number of backup, restore and import jobs which failed since the exporter started.",
+		}, []string{"type"}),
+	}
+	for _, source := range dataJobSources {
+		state.failures.WithLabelValues(source.jobType)
+	}
+	return state
+}
+
+// ScrapeDataJobs collects from `SHOW BACKUPS`, `SHOW RESTORES` and `SHOW IMPORT JOBS`.
+type ScrapeDataJobs struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeDataJobs) Name() string {
+	return "data_jobs"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeDataJobs) Help() string {
+	return "Collect state and progress of backup, restore and import jobs from SHOW BACKUPS, SHOW RESTORES and SHOW IMPORT JOBS"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeDataJobs) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (s ScrapeDataJobs) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Each job failing after the first scrape of the target is counted once while it is listed.
+func (s ScrapeDataJobs) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	var nowValue string
+	if err := db.QueryRowContext(ctx, dataJobsNowQuery).Scan(&nowValue); err != nil {
+		return err
+	}
+	now, ok := parseTiDBTime(nowValue)
+	if !ok {
+		return fmt.Errorf("couldn't parse the time of the server %q", nowValue)
+	}
+
+	state := targetState.Load(s.Name(), newDataJobsState).(*dataJobsState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	failed := make(map[string]bool)
+	for _, source := range dataJobSources {
+		prefix := source.jobType + "/"
+		jobRows, err := db.QueryContext(ctx, source.query)
+		if err != nil {
+			// The statement is missing in older versions, there are no jobs of this type then.
+			level.Debug(logger).Log("msg", "Error listing jobs", "type", source.jobType, "err", err)
+			for key := range state.failed {
+				if strings.HasPrefix(key, prefix) {
+					failed[key] = true
+				}
+			}
+			continue
+		}
+		err = source.scrape(jobRows, ch, func(id string) {
+			key := prefix + id
+			if state.initialized[source.jobType] && !state.failed[key] {
+				state.failures.WithLabelValues(source.jobType).Inc()
+			}
+			failed[key] = true
+		}, now.Unix(), int64(*dataJobsWindow))
+		jobRows.Close()
+		if err != nil {
+			return err
+		}
+		state.initialized[source.jobType] = true
+	}
+	state.failed = failed
+
+	state.failures.Collect(ch)
+	return nil
+}
+
+// scrape exports the jobs which are running or ended within window seconds, and reports all failed jobs to fail.
+func (source dataJobSource) scrape(jobRows *sql.Rows, ch chan<- prometheus.Metric, fail func(id string), now, window int64) error {
+	columns, err := jobRows.Columns()
+	if err != nil {
+		return err
+	}
+	for jobRows.Next() {
+		row, err := scanColumns(jobRows, columns)
+		if err != nil {
+			return err
+		}
+		id := row[source.id]
+		state := row[source.state]
+		if strings.EqualFold(state, "failed") {
+			fail(id)
+		}
+
+		end := now
+		if ended, ok := parseTiDBTime(row[source.end]); ok {
+			if now-ended.Unix() > window {
+				continue
+			}
+			end = ended.Unix()
+		}
+
+		kind, hash := parseDataJobDestination(row[source.destination])
+		ch <- prometheus.MustNewConstMetric(dataJobInfoDesc, prometheus.GaugeValue, 1,
+			source.jobType, id, state, kind, hash)
+
+		if progress, err := strconv.ParseFloat(strings.TrimSuffix(row[source.progress], "%"), 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(dataJobProgressDesc, prometheus.GaugeValue, progress, source.jobType, id)
+		}
+		if bytes, ok := parseByteSize(row[source.bytes]); ok {
+			ch <- prometheus.MustNewConstMetric(dataJobBytesDesc, prometheus.GaugeValue, bytes, source.jobType, id)
+		}
+		if rows, err := strconv.ParseFloat(row[source.rows], 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(dataJobRowsDesc, prometheus.GaugeValue, rows, source.jobType, id)
+		}
+		if start, ok := parseTiDBTime(row[source.start]); ok {
+			ch <- prometheus.MustNewConstMetric(dataJobElapsedDesc, prometheus.GaugeValue, float64(end-start.Unix()), source.jobType, id)
+		}
+	}
+	return jobRows.Err()
+}
+
+// parseDataJobDestination returns the kind of storage of a destination and a hash of its location,
+// so that the bucket and path, as well as any credentials in the URL, are not exported.
+func parseDataJobDestination(destination string) (kind, hash string) {
+	if destination == "" {
+		return "", ""
+	}
+	location := destination
+	if u, err := url.Parse(destination); err == nil {
+		kind = u.Scheme
+		location = u.Scheme + "://" + u.Host + u.Path
+	}
+	if kind == "" {
+		kind = "local"
+	}
+	sum := sha256.Sum256([]byte(location))
+	return kind, hex.EncodeToString(sum[:4])
+}
+
+// check interface
+var _ StatefulScraper = ScrapeDataJobs{}
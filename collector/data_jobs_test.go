@@ -0,0 +1,148 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeDataJobs(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{"--collect.data_jobs.window=7200"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	brColumns := []string{"Id_", "Destination", "State", "Progress", "Queue_time", "Execution_time", "Finish_time", "Connection", "Message"}
+	importColumns := []string{"Job_ID", "Data_Source", "Target_Table", "Table_ID", "Phase", "Status", "Source_File_Size",
+		"Imported_Rows", "Result_Message", "Create_Time", "Start_Time", "End_Time", "Created_By", "Last_Update_Time",
+		"Cur_Step", "Cur_Step_Processed_Size", "Cur_Step_Total_Size", "Cur_Step_Progress_Pct", "Cur_Step_Speed", "Cur_Step_ETA"}
+
+	mock.ExpectQuery(sanitizeQuery(dataJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow("2026-10-16 10:00:00"))
+	mock.ExpectQuery(sanitizeQuery(showBackupsQuery)).WillReturnRows(sqlmock.NewRows(brColumns).
+		AddRow(3, "s3://backup-bucket/nightly/2026-10-16?access-key=AKIA&secret-access-key=secret", "Backup", 42.5,
+			"2026-10-16 08:59:00", "2026-10-16 09:00:00", "0000-00-00 00:00:00", 1001, nil))
+	mock.ExpectQuery(sanitizeQuery(showRestoresQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	// The import job failed before the first scrape and is not counted, the job which ended before the window is not exported.
+	mock.ExpectQuery(sanitizeQuery(showImportJobsQuery)).WillReturnRows(sqlmock.NewRows(importColumns).
+		AddRow(7, "local:///mnt/backup", "`shop`.`orders`", 110, "import", "failed", "1.5GiB",
+			nil, "checksum mismatch", "2026-10-16 08:00:00", "2026-10-16 08:00:10", "2026-10-16 08:30:10", "root@%",
+			"2026-10-16 08:30:10", "import", "512MiB", "1.5GiB", nil, nil, nil).
+		AddRow(5, "s3://import-bucket/orders", "`shop`.`orders`", 110, "import", "finished", "1.5GiB",
+			1000000, "", "2026-10-15 08:00:00", "2026-10-15 08:00:10", "2026-10-15 08:30:10", "root@%",
+			"2026-10-15 08:30:10", nil, nil, nil, nil, nil, nil))
+
+	mock.ExpectQuery(sanitizeQuery(dataJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow("2026-10-16 10:00:15"))
+	mock.ExpectQuery(sanitizeQuery(showBackupsQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	mock.ExpectQuery(sanitizeQuery(showRestoresQuery)).WillReturnRows(sqlmock.NewRows(brColumns).
+		AddRow(4, "gcs://restore-bucket/full", "Failed", 10, "2026-10-16 10:00:00", "2026-10-16 10:00:05", "2026-10-16 10:00:10", 1002, "no space left"))
+	mock.ExpectQuery(sanitizeQuery(showImportJobsQuery)).WillReturnRows(sqlmock.NewRows(importColumns).
+		AddRow(7, "local:///mnt/backup", "`shop`.`orders`", 110, "import", "failed", "1.5GiB",
+			nil, "checksum mismatch", "2026-10-16 08:00:00", "2026-10-16 08:00:10", "2026-10-16 08:30:10", "root@%",
+			"2026-10-16 08:30:10", "import", "512MiB", "1.5GiB", nil, nil, nil))
+
+	// Listing the import jobs fails, there are none then, and the failed job is not counted again once it is listed.
+	mock.ExpectQuery(sanitizeQuery(dataJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow("2026-10-16 10:00:30"))
+	mock.ExpectQuery(sanitizeQuery(showBackupsQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	mock.ExpectQuery(sanitizeQuery(showRestoresQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	mock.ExpectQuery(sanitizeQuery(showImportJobsQuery)).WillReturnError(fmt.Errorf("connection reset"))
+	mock.ExpectQuery(sanitizeQuery(dataJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow("2026-10-16 10:00:45"))
+	mock.ExpectQuery(sanitizeQuery(showBackupsQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	mock.ExpectQuery(sanitizeQuery(showRestoresQuery)).WillReturnRows(sqlmock.NewRows(brColumns))
+	mock.ExpectQuery(sanitizeQuery(showImportJobsQuery)).WillReturnRows(sqlmock.NewRows(importColumns).
+		AddRow(7, "local:///mnt/backup", "`shop`.`orders`", 110, "import", "failed", "1.5GiB",
+			nil, "checksum mismatch", "2026-10-16 08:00:00", "2026-10-16 08:00:10", "2026-10-16 08:30:10", "root@%",
+			"2026-10-16 08:30:10", "import", "512MiB", "1.5GiB", nil, nil, nil))
+
+	backup := labelMap{"type": "backup", "id": "3"}
+	restore := labelMap{"type": "restore", "id": "4"}
+	importJob := labelMap{"type": "import", "id": "7"}
+	state := NewState().Target("")
+	expected := [][]MetricResult{
+		{
+			{labels: labelMap{"type": "backup", "id": "3", "state": "Backup", "destination_kind": "s3", "destination_hash": "5fc9bffd"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: backup, value: 42.5, metricType: dto.MetricType_GAUGE},
+			{labels: backup, value: 3600, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"type": "import", "id": "7", "state": "failed", "destination_kind": "local", "destination_hash": "d453f804"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 512 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 1800, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"type": "backup"}, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "restore"}, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "import"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"type": "restore", "id": "4", "state": "Failed", "destination_kind": "gcs", "destination_hash": "b3d3a822"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: restore, value: 10, metricType: dto.MetricType_GAUGE},
+			{labels: restore, value: 5, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"type": "import", "id": "7", "state": "failed", "destination_kind": "local", "destination_hash": "d453f804"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 512 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 1800, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"type": "backup"}, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "restore"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "import"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"type": "backup"}, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "restore"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "import"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"type": "import", "id": "7", "state": "failed", "destination_kind": "local", "destination_hash": "d453f804"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 512 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+			{labels: importJob, value: 1800, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"type": "backup"}, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "restore"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"type": "import"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, scrape := range expected {
+			ch := make(chan prometheus.Metric)
+			go func() {
+				if err := (ScrapeDataJobs{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+					t.Errorf("error calling function on test: %s", err)
+				}
+				close(ch)
+			}()
+			got := []MetricResult{}
+			for m := range ch {
+				got = append(got, readMetric(m))
+			}
+			// Counters are collected in no particular order.
+			convey.So(got, convey.ShouldHaveLength, len(scrape))
+			for _, expect := range scrape {
+				convey.So(got, convey.ShouldContain, expect)
+			}
+		}
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
@@ -81,10 +81,12 @@ type Exporter struct {
 	dsn      string
 	scrapers []Scraper
 	metrics  Metrics
+	state    *State
 }
 
 // New returns a new MySQL exporter for the provided DSN.
-func New(ctx context.Context, dsn string, metrics Metrics, scrapers []Scraper, logger log.Logger) *Exporter {
+// State is kept for stateful scrapers between exporters sharing the same state and DSN, it may be nil.
+func New(ctx context.Context, dsn string, metrics Metrics, state *State, scrapers []Scraper, logger log.Logger) *Exporter {
 	// Setup extra params for the DSN, default to having a lock timeout.
 	dsnParams := []string{fmt.Sprintf(timeoutParam, *exporterLockTimeout)}
 
@@ -105,6 +107,7 @@ func New(ctx context.Context, dsn string, metrics Metrics, scrapers []Scraper, l
 		dsn:      dsn,
 		scrapers: scrapers,
 		metrics:  metrics,
+		state:    state,
 	}
 }
 
@@ -158,6 +161,10 @@ func (e *Exporter) scrape(ctx context.Context, ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(scrapeDurationDesc, prometheus.GaugeValue, time.Since(scrapeTime).Seconds(), "connection")
 
 	version := getMySQLVersion(db, e.logger)
+	var targetState *TargetState
+	if e.state != nil {
+		targetState = e.state.Target(e.dsn)
+	}
 	var wg sync.WaitGroup
 	defer wg.Wait()
 	for _, scraper := range e.scrapers {
@@ -170,7 +177,14 @@ func (e *Exporter) scrape(ctx context.Context, ch chan<- prometheus.Metric) {
 			defer wg.Done()
 			label := "collect." + scraper.Name()
 			scrapeTime := time.Now()
-			if err := scraper.Scrape(ctx, db, ch, log.With(e.logger, "scraper", scraper.Name())); err != nil {
+			logger := log.With(e.logger, "scraper", scraper.Name())
+			var err error
+			if stateful, ok := scraper.(StatefulScraper); ok && targetState != nil {
+				err = stateful.ScrapeWithState(ctx, db, ch, targetState, logger)
+			} else {
+				err = scraper.Scrape(ctx, db, ch, logger)
+			}
+			if err != nil {
 				level.Error(e.logger).Log("msg", "Error from scraper", "scraper", scraper.Name(), "err", err)
 				e.metrics.ScrapeErrors.WithLabelValues(label).Inc()
 				e.metrics.Error.Set(1)
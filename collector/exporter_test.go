@@ -34,6 +34,7 @@ func TestExporter(t *testing.T) {
 		context.Background(),
 		dsn,
 		NewMetrics(),
+		NewState(),
 		[]Scraper{
 			ScrapeGlobalStatus{},
 		},
@@ -15,3 +15,6 @@ package collector
 
 // Subsystem.
 const informationSchema = "info_schema"
+
+// Subsystem for cluster-wide metrics read from information_schema.cluster_* tables.
+const cluster = "cluster"
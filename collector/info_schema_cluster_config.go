@@ -0,0 +1,146 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_config`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// KEY is a reserved word and has to be quoted.
+const infoSchemaClusterConfigQuery = `
+		  SELECT
+		    TYPE,
+		    INSTANCE,
+		    ` + "`KEY`" + `,
+		    VALUE
+		  FROM information_schema.cluster_config
+		`
+
+// Tunable flags.
+var (
+	clusterConfigInfoKeys = kingpin.Flag(
+		"collect.info_schema.cluster_config.info_keys",
+		"The list of config keys to export the actual value of per instance, or '*' for all",
+	).Default("").String()
+	clusterConfigDriftExcludeKeys = kingpin.Flag(
+		"collect.info_schema.cluster_config.drift_exclude_keys",
+		"The list of config keys not to export the drift of, matched against the whole key or its last dot separated part",
+	).Default("addr,advertise-addr,status-addr,advertise-status-addr,advertise-address,host,status-host,port,status-port," +
+		"engine-addr,name,data-dir,dir,wal-dir,path,raftdb-path,temp-dir,tmp-storage-path,filename,log-file,slow-query-file," +
+		"client-urls,peer-urls,advertise-client-urls,advertise-peer-urls,initial-cluster").String()
+)
+
+// Metric descriptors.
+var (
+	clusterConfigDriftDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "config_drift"),
+		"The number of distinct values a config key has across the instances of a component type.",
+		[]string{"type", "key"}, nil)
+	clusterConfigInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "config_info"),
+		"The value of a config key on a TiDB cluster component instance.",
+		[]string{"type", "instance", "key", "value"}, nil)
+)
+
+// ScrapeClusterConfig collects from `information_schema.cluster_config`.
+type ScrapeClusterConfig struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeClusterConfig) Name() string {
+	return informationSchema + ".cluster_config"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeClusterConfig) Help() string {
+	return "Collect config drift across instances of each component type from information_schema.cluster_config"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeClusterConfig) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeClusterConfig) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	clusterConfigRows, err := db.QueryContext(ctx, infoSchemaClusterConfigQuery)
+	if err != nil {
+		return err
+	}
+	defer clusterConfigRows.Close()
+
+	var infoKeys listFilter
+	if *clusterConfigInfoKeys != "" {
+		infoKeys = newListFilter(*clusterConfigInfoKeys)
+	}
+	// Keys such as addresses and paths always differ between instances.
+	driftExcludeKeys := listFilter{}
+	if *clusterConfigDriftExcludeKeys != "" {
+		driftExcludeKeys = newListFilter(*clusterConfigDriftExcludeKeys)
+	}
+
+	var (
+		componentType string
+		instance      string
+		key           string
+		value         string
+	)
+	// Distinct values of every key, grouped by component type.
+	typeKeyValues := make(map[string]map[string]map[string]bool)
+
+	for clusterConfigRows.Next() {
+		err = clusterConfigRows.Scan(&componentType, &instance, &key, &value)
+		if err != nil {
+			return err
+		}
+		if *clusterConfigInfoKeys != "" && infoKeys.matches(key) {
+			ch <- prometheus.MustNewConstMetric(clusterConfigInfoDesc, prometheus.GaugeValue, 1,
+				componentType, instance, key, value)
+		}
+		if driftExcludeKeys.matches(key) || driftExcludeKeys.matches(key[strings.LastIndex(key, ".")+1:]) {
+			continue
+		}
+
+		if typeKeyValues[componentType] == nil {
+			typeKeyValues[componentType] = make(map[string]map[string]bool)
+		}
+		if typeKeyValues[componentType][key] == nil {
+			typeKeyValues[componentType][key] = make(map[string]bool)
+		}
+		typeKeyValues[componentType][key][value] = true
+	}
+	if err := clusterConfigRows.Err(); err != nil {
+		return err
+	}
+
+	for _, componentType := range sortedMapKeys(typeKeyValues) {
+		keyValues := typeKeyValues[componentType]
+		for _, key := range sortedMapKeys(keyValues) {
+			ch <- prometheus.MustNewConstMetric(clusterConfigDriftDesc, prometheus.GaugeValue,
+				float64(len(keyValues[key])), componentType, key)
+		}
+	}
+
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeClusterConfig{}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeClusterConfig(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.cluster_config.info_keys=storage.block-cache.capacity",
+		"--collect.info_schema.cluster_config.drift_exclude_keys=data-dir,server.addr",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"TYPE", "INSTANCE", "KEY", "VALUE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("tikv", "tikv-0:20160", "storage.block-cache.capacity", "24GiB").
+		AddRow("tikv", "tikv-0:20160", "raftstore.apply-pool-size", "2").
+		AddRow("tikv", "tikv-0:20160", "server.addr", "0.0.0.0:20160").
+		AddRow("tikv", "tikv-0:20160", "storage.data-dir", "/data/tikv-0").
+		AddRow("tikv", "tikv-1:20160", "storage.block-cache.capacity", "24GiB").
+		AddRow("tikv", "tikv-1:20160", "raftstore.apply-pool-size", "4").
+		AddRow("tikv", "tikv-1:20160", "server.addr", "0.0.0.0:20161").
+		AddRow("tikv", "tikv-1:20160", "storage.data-dir", "/data/tikv-1").
+		AddRow("tikv", "tikv-2:20160", "storage.block-cache.capacity", "16GiB").
+		AddRow("tikv", "tikv-2:20160", "raftstore.apply-pool-size", "2").
+		AddRow("tidb", "tidb-0:4000", "performance.max-procs", "0").
+		AddRow("tidb", "tidb-1:4000", "performance.max-procs", "0")
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterConfigQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeClusterConfig{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "key": "storage.block-cache.capacity", "value": "24GiB"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-1:20160", "key": "storage.block-cache.capacity", "value": "24GiB"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-2:20160", "key": "storage.block-cache.capacity", "value": "16GiB"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "key": "performance.max-procs"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "key": "raftstore.apply-pool-size"}, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "key": "storage.block-cache.capacity"}, value: 2, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
+
+func TestScrapeClusterConfigDefaultDriftExcludeKeys(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	// Keys of each instance's addresses, paths and name differ by design.
+	columns := []string{"TYPE", "INSTANCE", "KEY", "VALUE"}
+	rows := sqlmock.NewRows(columns)
+	for _, instance := range []string{"pd-0", "pd-1"} {
+		rows.AddRow("pd", instance+":2379", "name", instance).
+			AddRow("pd", instance+":2379", "data-dir", "/data/"+instance).
+			AddRow("pd", instance+":2379", "advertise-client-urls", "http://"+instance+":2379").
+			AddRow("pd", instance+":2379", "log.file.filename", "/log/"+instance+".log").
+			AddRow("pd", instance+":2379", "schedule.leader-schedule-limit", "4")
+	}
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterConfigQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeClusterConfig{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"type": "pd", "key": "schedule.leader-schedule-limit"}, value: 1, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
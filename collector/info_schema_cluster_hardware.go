@@ -0,0 +1,195 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_hardware`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const infoSchemaClusterHardwareQuery = `
+		  SELECT
+		    TYPE,
+		    INSTANCE,
+		    DEVICE_TYPE,
+		    DEVICE_NAME,
+		    NAME,
+		    VALUE
+		  FROM information_schema.cluster_hardware
+		`
+
+// Metric descriptors.
+var (
+	clusterHardwareCPUInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_cpu_info"),
+		"The CPU model of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance", "model", "vendor_id", "frequency"}, nil)
+	clusterHardwareCPULogicalCoresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_cpu_logical_cores"),
+		"The number of logical CPU cores of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance"}, nil)
+	clusterHardwareMemoryTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_memory_total_bytes"),
+		"The total memory of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance"}, nil)
+	clusterHardwareDiskInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_disk_info"),
+		"The disks of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance", "device_name", "path", "fs_type"}, nil)
+	clusterHardwareDiskTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_disk_total_bytes"),
+		"The total size of a disk of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance", "device_name"}, nil)
+	clusterHardwareDiskFreeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_disk_free_bytes"),
+		"The free size of a disk of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance", "device_name"}, nil)
+	clusterHardwareNetInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "hardware_net_info"),
+		"The network interfaces of the host a TiDB cluster component runs on.",
+		[]string{"type", "instance", "device_name"}, nil)
+)
+
+// clusterHardwareInstance holds the hardware items of one component instance,
+// keyed by device type, device name and item name.
+type clusterHardwareInstance struct {
+	componentType string
+	instance      string
+	devices       map[string]map[string]map[string]string
+}
+
+func (h *clusterHardwareInstance) item(deviceType, deviceName, name string) (string, bool) {
+	value, ok := h.devices[deviceType][deviceName][name]
+	return value, ok
+}
+
+func (h *clusterHardwareInstance) gauge(ch chan<- prometheus.Metric, desc *prometheus.Desc, deviceType, deviceName, name string, labels ...string) {
+	value, ok := h.item(deviceType, deviceName, name)
+	if !ok {
+		return
+	}
+	floatValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, floatValue,
+		append([]string{h.componentType, h.instance}, labels...)...)
+}
+
+// ScrapeClusterHardware collects from `information_schema.cluster_hardware`.
+type ScrapeClusterHardware struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeClusterHardware) Name() string {
+	return informationSchema + ".cluster_hardware"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeClusterHardware) Help() string {
+	return "Collect CPU, memory, disk and network inventory per component from information_schema.cluster_hardware"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeClusterHardware) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeClusterHardware) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	clusterHardwareRows, err := db.QueryContext(ctx, infoSchemaClusterHardwareQuery)
+	if err != nil {
+		return err
+	}
+	defer clusterHardwareRows.Close()
+
+	var (
+		componentType string
+		instance      string
+		deviceType    string
+		deviceName    string
+		name          string
+		value         string
+	)
+	var instances []*clusterHardwareInstance
+	byInstance := make(map[string]*clusterHardwareInstance)
+
+	for clusterHardwareRows.Next() {
+		err = clusterHardwareRows.Scan(&componentType, &instance, &deviceType, &deviceName, &name, &value)
+		if err != nil {
+			return err
+		}
+		key := componentType + "/" + instance
+		hw, ok := byInstance[key]
+		if !ok {
+			hw = &clusterHardwareInstance{
+				componentType: componentType,
+				instance:      instance,
+				devices:       make(map[string]map[string]map[string]string),
+			}
+			byInstance[key] = hw
+			instances = append(instances, hw)
+		}
+		if hw.devices[deviceType] == nil {
+			hw.devices[deviceType] = make(map[string]map[string]string)
+		}
+		if hw.devices[deviceType][deviceName] == nil {
+			hw.devices[deviceType][deviceName] = make(map[string]string)
+		}
+		hw.devices[deviceType][deviceName][name] = value
+	}
+	if err := clusterHardwareRows.Err(); err != nil {
+		return err
+	}
+
+	for _, hw := range instances {
+		if _, ok := hw.devices["cpu"]["cpu"]; ok {
+			model, ok := hw.item("cpu", "cpu", "model-name")
+			if !ok {
+				model, _ = hw.item("cpu", "cpu", "cpu-model-name")
+			}
+			vendorID, _ := hw.item("cpu", "cpu", "cpu-vendor-id")
+			frequency, _ := hw.item("cpu", "cpu", "cpu-frequency")
+			ch <- prometheus.MustNewConstMetric(clusterHardwareCPUInfoDesc, prometheus.GaugeValue, 1,
+				hw.componentType, hw.instance, model, vendorID, frequency)
+			hw.gauge(ch, clusterHardwareCPULogicalCoresDesc, "cpu", "cpu", "cpu-logical-cores")
+		}
+
+		hw.gauge(ch, clusterHardwareMemoryTotalDesc, "memory", "memory", "capacity")
+
+		for _, disk := range sortedMapKeys(hw.devices["disk"]) {
+			path, _ := hw.item("disk", disk, "path")
+			fsType, _ := hw.item("disk", disk, "fstype")
+			ch <- prometheus.MustNewConstMetric(clusterHardwareDiskInfoDesc, prometheus.GaugeValue, 1,
+				hw.componentType, hw.instance, disk, path, fsType)
+			hw.gauge(ch, clusterHardwareDiskTotalDesc, "disk", disk, "total", disk)
+			hw.gauge(ch, clusterHardwareDiskFreeDesc, "disk", disk, "free", disk)
+		}
+
+		for _, nic := range sortedMapKeys(hw.devices["net"]) {
+			ch <- prometheus.MustNewConstMetric(clusterHardwareNetInfoDesc, prometheus.GaugeValue, 1,
+				hw.componentType, hw.instance, nic)
+		}
+	}
+
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeClusterHardware{}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeClusterHardware(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"TYPE", "INSTANCE", "DEVICE_TYPE", "DEVICE_NAME", "NAME", "VALUE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "cpu-logical-cores", "16").
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "cpu-physical-cores", "8").
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "cpu-frequency", "2500MHz").
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "cpu-vendor-id", "GenuineIntel").
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "model-name", "Intel(R) Xeon(R) Platinum 8259CL").
+		AddRow("tikv", "tikv-0:20160", "memory", "memory", "capacity", "68719476736").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme1n1", "fstype", "ext4").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme1n1", "path", "/var/lib/tikv").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme1n1", "total", "1000000000000").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme1n1", "free", "400000000000").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme0n1", "fstype", "xfs").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme0n1", "path", "/").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme0n1", "total", "100000000000").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme0n1", "free", "50000000000").
+		AddRow("tikv", "tikv-0:20160", "net", "eth0", "mtu", "9001").
+		AddRow("tidb", "tidb-0:4000", "cpu", "cpu", "cpu-logical-cores", "8").
+		AddRow("tidb", "tidb-0:4000", "cpu", "cpu", "cpu-vendor-id", "AuthenticAMD").
+		AddRow("tidb", "tidb-0:4000", "memory", "memory", "capacity", "17179869184")
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterHardwareQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeClusterHardware{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "model": "Intel(R) Xeon(R) Platinum 8259CL", "vendor_id": "GenuineIntel", "frequency": "2500MHz"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160"}, value: 16, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160"}, value: 68719476736, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme0n1", "path": "/", "fs_type": "xfs"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme0n1"}, value: 100000000000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme0n1"}, value: 50000000000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme1n1", "path": "/var/lib/tikv", "fs_type": "ext4"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme1n1"}, value: 1000000000000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "nvme1n1"}, value: 400000000000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_name": "eth0"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000", "model": "", "vendor_id": "AuthenticAMD", "frequency": ""}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000"}, value: 8, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000"}, value: 17179869184, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_info`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const infoSchemaClusterInfoQuery = `
+		  SELECT
+		    TYPE,
+		    INSTANCE,
+		    STATUS_ADDRESS,
+		    VERSION,
+		    GIT_HASH,
+		    START_TIME,
+		    UPTIME
+		  FROM information_schema.cluster_info
+		`
+
+// Metric descriptors.
+var (
+	clusterComponentInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "component_info"),
+		"Information about a TiDB cluster component instance.",
+		[]string{"type", "instance", "status_address", "version", "git_hash"}, nil)
+	clusterComponentStartTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "component_start_time_seconds"),
+		"The start time of a TiDB cluster component instance in unix seconds.",
+		[]string{"type", "instance"}, nil)
+	clusterComponentUptimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "component_uptime_seconds"),
+		"The number of seconds a TiDB cluster component instance has been up.",
+		[]string{"type", "instance"}, nil)
+	clusterComponentInstancesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "component_instances"),
+		"The number of instances by component type.",
+		[]string{"type"}, nil)
+	clusterComponentVersionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "component_versions"),
+		"The number of distinct versions running by component type.",
+		[]string{"type"}, nil)
+)
+
+// ScrapeClusterInfo collects from `information_schema.cluster_info`.
+type ScrapeClusterInfo struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeClusterInfo) Name() string {
+	return informationSchema + ".cluster_info"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeClusterInfo) Help() string {
+	return "Collect cluster topology, versions and uptime from information_schema.cluster_info"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeClusterInfo) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeClusterInfo) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	clusterInfoRows, err := db.QueryContext(ctx, infoSchemaClusterInfoQuery)
+	if err != nil {
+		return err
+	}
+	defer clusterInfoRows.Close()
+
+	var (
+		componentType string
+		instance      string
+		statusAddress string
+		version       string
+		gitHash       string
+		startTime     string
+		uptime        string
+	)
+	instanceCounts := make(map[string]uint32)
+	typeVersions := make(map[string]map[string]bool)
+
+	for clusterInfoRows.Next() {
+		err = clusterInfoRows.Scan(&componentType, &instance, &statusAddress, &version, &gitHash, &startTime, &uptime)
+		if err != nil {
+			return err
+		}
+
+		instanceCounts[componentType] += 1
+		if typeVersions[componentType] == nil {
+			typeVersions[componentType] = make(map[string]bool)
+		}
+		typeVersions[componentType][version] = true
+
+		ch <- prometheus.MustNewConstMetric(clusterComponentInfoDesc, prometheus.GaugeValue, 1,
+			componentType, instance, statusAddress, version, gitHash)
+
+		start, ok := parseTiDBTime(startTime)
+		if ok {
+			ch <- prometheus.MustNewConstMetric(clusterComponentStartTimeDesc, prometheus.GaugeValue,
+				float64(start.Unix()), componentType, instance)
+		} else {
+			level.Debug(logger).Log("msg", "Failed to parse start time", "instance", instance, "start_time", startTime)
+		}
+
+		if duration, err := time.ParseDuration(uptime); err == nil {
+			ch <- prometheus.MustNewConstMetric(clusterComponentUptimeDesc, prometheus.GaugeValue,
+				duration.Seconds(), componentType, instance)
+		} else if ok {
+			ch <- prometheus.MustNewConstMetric(clusterComponentUptimeDesc, prometheus.GaugeValue,
+				time.Since(start).Seconds(), componentType, instance)
+		}
+	}
+	if err := clusterInfoRows.Err(); err != nil {
+		return err
+	}
+
+	for _, componentType := range sortedMapKeys(instanceCounts) {
+		ch <- prometheus.MustNewConstMetric(clusterComponentInstancesDesc, prometheus.GaugeValue,
+			float64(instanceCounts[componentType]), componentType)
+		ch <- prometheus.MustNewConstMetric(clusterComponentVersionsDesc, prometheus.GaugeValue,
+			float64(len(typeVersions[componentType])), componentType)
+	}
+
+	return nil
+}
+
+// parseTiDBTime parses the timestamp formats TiDB uses in information_schema tables.
+func parseTiDBTime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		if ts, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return ts, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// check interface
+var _ Scraper = ScrapeClusterInfo{}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeClusterInfo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"TYPE", "INSTANCE", "STATUS_ADDRESS", "VERSION", "GIT_HASH", "START_TIME", "UPTIME"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("tidb", "tidb-0:4000", "tidb-0:10080", "7.5.0", "069e8a2", "2026-10-01T08:00:00Z", "1h30m0s").
+		AddRow("pd", "pd-0:2379", "pd-0:2379", "7.5.0", "2a3b4c5", "2026-10-01T07:00:00Z", "2h30m0s").
+		AddRow("tikv", "tikv-0:20160", "tikv-0:20180", "7.5.0", "d3e4f5a", "2026-10-01T07:30:00Z", "2h0m0s").
+		AddRow("tikv", "tikv-1:20160", "tikv-1:20180", "7.1.2", "b6c7d8e", "2026-10-01T07:30:00Z", "2h0m0.5s")
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterInfoQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeClusterInfo{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000", "status_address": "tidb-0:10080", "version": "7.5.0", "git_hash": "069e8a2"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000"}, value: 1790841600, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000"}, value: 5400, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "pd", "instance": "pd-0:2379", "status_address": "pd-0:2379", "version": "7.5.0", "git_hash": "2a3b4c5"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "pd", "instance": "pd-0:2379"}, value: 1790838000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "pd", "instance": "pd-0:2379"}, value: 9000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "status_address": "tikv-0:20180", "version": "7.5.0", "git_hash": "d3e4f5a"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160"}, value: 1790839800, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160"}, value: 7200, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-1:20160", "status_address": "tikv-1:20180", "version": "7.1.2", "git_hash": "b6c7d8e"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-1:20160"}, value: 1790839800, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-1:20160"}, value: 7200.5, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "pd"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "pd"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv"}, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv"}, value: 2, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
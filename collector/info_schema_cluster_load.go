@@ -0,0 +1,147 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_load`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const infoSchemaClusterLoadQuery = `
+		  SELECT
+		    TYPE,
+		    INSTANCE,
+		    DEVICE_TYPE,
+		    DEVICE_NAME,
+		    NAME,
+		    VALUE
+		  FROM information_schema.cluster_load
+		`
+
+// Tunable flags.
+var (
+	clusterLoadDeviceTypes = kingpin.Flag(
+		"collect.info_schema.cluster_load.device_types",
+		"The list of device types (cpu, memory, net, disk) to collect load for, or '*' for all",
+	).Default("*").String()
+	clusterLoadNames = kingpin.Flag(
+		"collect.info_schema.cluster_load.names",
+		"The list of load item names to collect, or '*' for all",
+	).Default("*").String()
+)
+
+// Metric descriptors.
+var (
+	clusterLoadDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cluster, "load"),
+		"The load of a TiDB cluster component from information_schema.cluster_load.",
+		[]string{"type", "instance", "device_type", "device_name", "name"}, nil)
+)
+
+// ScrapeClusterLoad collects from `information_schema.cluster_load`.
+type ScrapeClusterLoad struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeClusterLoad) Name() string {
+	return informationSchema + ".cluster_load"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeClusterLoad) Help() string {
+	return "Collect CPU, memory, network and disk load per component from information_schema.cluster_load"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeClusterLoad) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeClusterLoad) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	clusterLoadRows, err := db.QueryContext(ctx, infoSchemaClusterLoadQuery)
+	if err != nil {
+		return err
+	}
+	defer clusterLoadRows.Close()
+
+	deviceTypes := newListFilter(*clusterLoadDeviceTypes)
+	names := newListFilter(*clusterLoadNames)
+
+	var (
+		componentType string
+		instance      string
+		deviceType    string
+		deviceName    string
+		name          string
+		value         string
+	)
+	for clusterLoadRows.Next() {
+		err = clusterLoadRows.Scan(&componentType, &instance, &deviceType, &deviceName, &name, &value)
+		if err != nil {
+			return err
+		}
+		if !deviceTypes.matches(deviceType) || !names.matches(name) {
+			continue
+		}
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			// Silently skip non-numeric values.
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(clusterLoadDesc, prometheus.GaugeValue, floatValue,
+			componentType, instance, deviceType, deviceName, name)
+	}
+	return clusterLoadRows.Err()
+}
+
+// listFilter matches values against a comma separated list flag, where '*' matches everything.
+type listFilter map[string]bool
+
+func newListFilter(list string) listFilter {
+	if list == "*" {
+		return nil
+	}
+	filter := listFilter{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			filter[item] = true
+		}
+	}
+	return filter
+}
+
+func (f listFilter) matches(value string) bool {
+	return f == nil || f[value]
+}
+
+// sqlList returns the values as a list of quoted SQL strings, in sorted order.
+func (f listFilter) sqlList() string {
+	values := sortedMapKeys(f)
+	for i, value := range values {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+		values[i] = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	}
+	return strings.Join(values, ", ")
+}
+
+// check interface
+var _ Scraper = ScrapeClusterLoad{}
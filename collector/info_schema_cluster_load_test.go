@@ -0,0 +1,81 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeClusterLoad(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.cluster_load.device_types=cpu,memory",
+		"--collect.info_schema.cluster_load.names=load1,usage,used-percent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"TYPE", "INSTANCE", "DEVICE_TYPE", "DEVICE_NAME", "NAME", "VALUE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "load1", "1.25").
+		AddRow("tikv", "tikv-0:20160", "cpu", "cpu", "load5", "1.5").
+		AddRow("tikv", "tikv-0:20160", "cpu", "usage", "usage", "0.42").
+		AddRow("tikv", "tikv-0:20160", "memory", "virtual", "used-percent", "0.61").
+		AddRow("tikv", "tikv-0:20160", "disk", "nvme0n1", "usage", "0.1").
+		AddRow("tidb", "tidb-0:4000", "cpu", "cpu", "load1", "0.75").
+		AddRow("tidb", "tidb-0:4000", "cpu", "cpu", "load1", "n/a")
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterLoadQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeClusterLoad{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_type": "cpu", "device_name": "cpu", "name": "load1"}, value: 1.25, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_type": "cpu", "device_name": "usage", "name": "usage"}, value: 0.42, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tikv", "instance": "tikv-0:20160", "device_type": "memory", "device_name": "virtual", "name": "used-percent"}, value: 0.61, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"type": "tidb", "instance": "tidb-0:4000", "device_type": "cpu", "device_name": "cpu", "name": "load1"}, value: 0.75, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.ddl_jobs` and `ADMIN SHOW DDL`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	adminShowDDLQuery = `ADMIN SHOW DDL`
+	ddlJobsNowQuery   = `SELECT NOW()`
+	// Jobs which have not finished yet, older TiDB versions report queueing jobs in state 'none'.
+	infoSchemaDDLActiveJobsQuery = `
+		  SELECT
+		    JOB_ID,
+		    JOB_TYPE,
+		    ifnull(DB_NAME, '') as DB_NAME,
+		    ifnull(TABLE_NAME, '') as TABLE_NAME,
+		    IF(STATE = 'none', 'queueing', STATE) as STATE,
+		    ifnull(ROW_COUNT, 0) as ROW_COUNT,
+		    ifnull(TIMESTAMPDIFF(SECOND, START_TIME, NOW()), 0) as ELAPSED
+		  FROM information_schema.ddl_jobs
+		  WHERE STATE IN ('none', 'queueing', 'running')
+		`
+	infoSchemaDDLCancelledJobsQuery = `
+		  SELECT
+		    JOB_TYPE,
+		    STATE,
+		    COUNT(*) as JOBS
+		  FROM information_schema.ddl_jobs
+		  WHERE STATE IN ('cancelled', 'rollback done')
+		    AND END_TIME > '%s' AND END_TIME <= '%s'
+		  GROUP BY JOB_TYPE, STATE
+		`
+)
+
+// Job types which reorganize data and report the rows processed so far.
+var ddlReorgJobTypes = []string{"add index", "add primary key", "modify column", "reorganize partition"}
+
+// Metric descriptors.
+var (
+	ddlJobsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "ddl_jobs"),
+		"The number of queueing and running DDL jobs by job type.",
+		[]string{"job_type", "state"}, nil)
+	ddlOldestRunningJobDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "ddl_oldest_running_job_seconds"),
+		"The elapsed time of the oldest running DDL job, 0 when there is none.",
+		nil, nil)
+	ddlJobRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "ddl_job_rows_processed"),
+		"The number of rows processed so far by a running reorg DDL job.",
+		[]string{"job_id", "job_type", "schema", "table"}, nil)
+	ddlSchemaVersionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "ddl_schema_version"),
+		"The current schema version.",
+		nil, nil)
+	ddlOwnerDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "ddl_owner_info"),
+		"The current DDL owner, the value is always 1.",
+		[]string{"owner_id", "owner_address"}, nil)
+)
+
+// ddlJobsState is kept between scrapes of a target.
+type ddlJobsState struct {
+	mu sync.Mutex
+	// End time up to which the cancelled jobs are counted.
+	cursor    string
+	cancelled *prometheus.CounterVec
+}
+
+func newDDLJobsState() interface{} {
+	return &ddlJobsState{
+		cancelled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "ddl_jobs_cancelled_total",
+			Help:      "The number of DDL jobs which ended cancelled or rolled back by job type and state.",
+		}, []string{"job_type", "state"}),
+	}
+}
+
+// ScrapeDDLJobs collects from `information_schema.ddl_jobs`.
+type ScrapeDDLJobs struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeDDLJobs) Name() string {
+	return informationSchema + ".ddl_jobs"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeDDLJobs) Help() string {
+	return "Collect DDL job queue, progress and owner from information_schema.ddl_jobs and ADMIN SHOW DDL"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeDDLJobs) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+// Without state kept between scrapes, no cancelled jobs are counted.
+func (s ScrapeDDLJobs) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Each job which ends cancelled after the first scrape of the target is counted once.
+func (s ScrapeDDLJobs) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	if err := scrapeAdminShowDDL(ctx, db, ch); err != nil {
+		return err
+	}
+	if err := scrapeDDLActiveJobs(ctx, db, ch); err != nil {
+		return err
+	}
+
+	state := targetState.Load(s.Name(), newDDLJobsState).(*ddlJobsState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	var now string
+	if err := db.QueryRowContext(ctx, ddlJobsNowQuery).Scan(&now); err != nil {
+		return err
+	}
+	// Jobs cancelled before the first scrape are not counted.
+	if state.cursor != "" {
+		if err := state.readCancelledJobs(ctx, db, now); err != nil {
+			return err
+		}
+	}
+	state.cursor = now
+
+	state.cancelled.Collect(ch)
+	return nil
+}
+
+// scrapeAdminShowDDL sends the schema version and owner, the columns are looked up by name as they differ between versions.
+func scrapeAdminShowDDL(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric) error {
+	ddlRows, err := db.QueryContext(ctx, adminShowDDLQuery)
+	if err != nil {
+		return err
+	}
+	defer ddlRows.Close()
+
+	columns, err := ddlRows.Columns()
+	if err != nil {
+		return err
+	}
+	for ddlRows.Next() {
+		row, err := scanColumns(ddlRows, columns)
+		if err != nil {
+			return err
+		}
+		if version, err := strconv.ParseFloat(row["SCHEMA_VER"], 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(ddlSchemaVersionDesc, prometheus.GaugeValue, version)
+		}
+		ch <- prometheus.MustNewConstMetric(ddlOwnerDesc, prometheus.GaugeValue, 1, row["OWNER_ID"], row["OWNER_ADDRESS"])
+	}
+	return ddlRows.Err()
+}
+
+func scrapeDDLActiveJobs(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric) error {
+	jobRows, err := db.QueryContext(ctx, infoSchemaDDLActiveJobsQuery)
+	if err != nil {
+		return err
+	}
+	defer jobRows.Close()
+
+	var (
+		jobID    string
+		jobType  string
+		database string
+		table    string
+		state    string
+		rowCount float64
+		elapsed  float64
+		oldest   float64
+	)
+	jobs := make(map[string]map[string]float64)
+	for jobRows.Next() {
+		if err := jobRows.Scan(&jobID, &jobType, &database, &table, &state, &rowCount, &elapsed); err != nil {
+			return err
+		}
+		if jobs[jobType] == nil {
+			jobs[jobType] = make(map[string]float64)
+		}
+		jobs[jobType][state]++
+		if state != "running" {
+			continue
+		}
+		if elapsed > oldest {
+			oldest = elapsed
+		}
+		if isDDLReorgJob(jobType) {
+			ch <- prometheus.MustNewConstMetric(ddlJobRowsDesc, prometheus.GaugeValue, rowCount, jobID, jobType, database, table)
+		}
+	}
+	if err := jobRows.Err(); err != nil {
+		return err
+	}
+
+	for _, jobType := range sortedMapKeys(jobs) {
+		for _, state := range []string{"queueing", "running"} {
+			ch <- prometheus.MustNewConstMetric(ddlJobsDesc, prometheus.GaugeValue, jobs[jobType][state], jobType, state)
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(ddlOldestRunningJobDesc, prometheus.GaugeValue, oldest)
+	return nil
+}
+
+func (state *ddlJobsState) readCancelledJobs(ctx context.Context, db *sql.DB, now string) error {
+	jobRows, err := db.QueryContext(ctx, fmt.Sprintf(infoSchemaDDLCancelledJobsQuery, state.cursor, now))
+	if err != nil {
+		return err
+	}
+	defer jobRows.Close()
+
+	var (
+		jobType  string
+		jobState string
+		jobs     float64
+	)
+	for jobRows.Next() {
+		if err := jobRows.Scan(&jobType, &jobState, &jobs); err != nil {
+			return err
+		}
+		state.cancelled.WithLabelValues(jobType, jobState).Add(jobs)
+	}
+	return jobRows.Err()
+}
+
+// isDDLReorgJob reports whether the job type reorganizes data, newer versions append the reorg method to it.
+func isDDLReorgJob(jobType string) bool {
+	for _, reorgType := range ddlReorgJobTypes {
+		if strings.HasPrefix(jobType, reorgType) {
+			return true
+		}
+	}
+	return false
+}
+
+// check interface
+var _ StatefulScraper = ScrapeDDLJobs{}
@@ -0,0 +1,140 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	adminShowDDLColumns  = []string{"SCHEMA_VER", "OWNER_ID", "OWNER_ADDRESS", "RUNNING_JOBS", "SELF_ID", "QUERY"}
+	ddlActiveJobsColumns = []string{"JOB_ID", "JOB_TYPE", "DB_NAME", "TABLE_NAME", "STATE", "ROW_COUNT", "ELAPSED"}
+)
+
+func TestScrapeDDLJobs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(sanitizeQuery(adminShowDDLQuery)).WillReturnRows(sqlmock.NewRows(adminShowDDLColumns).
+		AddRow(1024, "a1b2c3", "10.0.1.1:4000", "ID:120, Type:add index", "a1b2c3", "ALTER TABLE orders ADD INDEX idx_user (user_id)"))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaDDLActiveJobsQuery)).WillReturnRows(sqlmock.NewRows(ddlActiveJobsColumns).
+		AddRow(120, "add index /* txn-merge */", "shop", "orders", "running", 5000000, 3600).
+		AddRow(121, "create table", "shop", "items", "queueing", 0, 10).
+		AddRow(122, "add index", "shop", "items", "queueing", 0, 10).
+		AddRow(123, "truncate table", "shop", "carts", "running", 0, 2))
+	mock.ExpectQuery(sanitizeQuery(ddlJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).
+		AddRow("2026-10-16 10:00:00"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeDDLJobs{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{}, value: 1024, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"owner_id": "a1b2c3", "owner_address": "10.0.1.1:4000"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_id": "120", "job_type": "add index /* txn-merge */", "schema": "shop", "table": "orders"}, value: 5000000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "add index", "state": "queueing"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "add index", "state": "running"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "add index /* txn-merge */", "state": "queueing"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "add index /* txn-merge */", "state": "running"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "create table", "state": "queueing"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "create table", "state": "running"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "truncate table", "state": "queueing"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"job_type": "truncate table", "state": "running"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 3600, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
+
+func TestScrapeDDLJobsCancelled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	for _, now := range []string{"2026-10-16 10:00:00", "2026-10-16 10:00:15"} {
+		mock.ExpectQuery(sanitizeQuery(adminShowDDLQuery)).WillReturnRows(sqlmock.NewRows(adminShowDDLColumns))
+		mock.ExpectQuery(sanitizeQuery(infoSchemaDDLActiveJobsQuery)).WillReturnRows(sqlmock.NewRows(ddlActiveJobsColumns))
+		mock.ExpectQuery(sanitizeQuery(ddlJobsNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow(now))
+	}
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaDDLCancelledJobsQuery, "2026-10-16 10:00:00", "2026-10-16 10:00:15"))).
+		WillReturnRows(sqlmock.NewRows([]string{"JOB_TYPE", "STATE", "JOBS"}).
+			AddRow("add index", "rollback done", 2).
+			AddRow("modify column", "cancelled", 1))
+
+	state := NewState().Target("")
+	expected := [][]MetricResult{
+		{
+			{labels: labelMap{}, value: 0, metricType: dto.MetricType_GAUGE},
+		},
+		{
+			{labels: labelMap{}, value: 0, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"job_type": "add index", "state": "rollback done"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"job_type": "modify column", "state": "cancelled"}, value: 1, metricType: dto.MetricType_COUNTER},
+		},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, scrape := range expected {
+			ch := make(chan prometheus.Metric)
+			go func() {
+				if err := (ScrapeDDLJobs{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+					t.Errorf("error calling function on test: %s", err)
+				}
+				close(ch)
+			}()
+			got := []MetricResult{}
+			for m := range ch {
+				got = append(got, readMetric(m))
+			}
+			// Counters are collected in no particular order.
+			convey.So(got, convey.ShouldHaveLength, len(scrape))
+			for _, expect := range scrape {
+				convey.So(got, convey.ShouldContain, expect)
+			}
+		}
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
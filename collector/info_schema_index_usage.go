@@ -0,0 +1,196 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_tidb_index_usage` and `sys.schema_unused_indexes`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	// Each TiDB server counts the usage of the queries it ran, the usage is
+	// summed over the cluster so it does not depend on the server connected to.
+	// Most used indexes first, unused indexes are counted by unused_indexes.
+	infoSchemaIndexUsageQuery = `
+		  SELECT
+		    TABLE_SCHEMA,
+		    TABLE_NAME,
+		    INDEX_NAME,
+		    SUM(QUERY_TOTAL) as QUERY_TOTAL,
+		    SUM(PERCENTAGE_ACCESS_0) as PERCENTAGE_ACCESS_0,
+		    SUM(PERCENTAGE_ACCESS_0_1) as PERCENTAGE_ACCESS_0_1,
+		    SUM(PERCENTAGE_ACCESS_1_10) as PERCENTAGE_ACCESS_1_10,
+		    SUM(PERCENTAGE_ACCESS_10_20) as PERCENTAGE_ACCESS_10_20,
+		    SUM(PERCENTAGE_ACCESS_20_50) as PERCENTAGE_ACCESS_20_50,
+		    SUM(PERCENTAGE_ACCESS_50_100) as PERCENTAGE_ACCESS_50_100,
+		    SUM(PERCENTAGE_ACCESS_100) as PERCENTAGE_ACCESS_100,
+		    TIMESTAMPDIFF(SECOND, MAX(LAST_ACCESS_TIME), NOW()) as LAST_ACCESS_AGE
+		  FROM information_schema.cluster_tidb_index_usage
+		  GROUP BY TABLE_SCHEMA, TABLE_NAME, INDEX_NAME
+		  ORDER BY SUM(QUERY_TOTAL) DESC, MAX(LAST_ACCESS_TIME) DESC
+		`
+	sysUnusedIndexesQuery = `
+		  SELECT
+		    object_schema,
+		    object_name
+		  FROM sys.schema_unused_indexes
+		`
+)
+
+// Tunable flags.
+var (
+	indexUsageTables = kingpin.Flag(
+		"collect.info_schema.index_usage.tables",
+		"The list of tables as schema.table to collect index usage for, or '*' for all",
+	).Default("*").String()
+	indexUsageLimit = kingpin.Flag(
+		"collect.info_schema.index_usage.limit",
+		"Limit the number of most used indexes to export the usage of",
+	).Default("100").Int()
+)
+
+// Buckets of the percentage of the rows of a table a query selects through an index.
+var indexUsageAccessBuckets = []string{"0", "0-1", "1-10", "10-20", "20-50", "50-100", "100"}
+
+// Metric descriptors.
+var (
+	indexUsageQueriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "index_usage_queries_total"),
+		"The number of queries which used an index, summed over the TiDB servers.",
+		[]string{"schema", "table", "index"}, nil)
+	indexUsageAccessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "index_usage_queries_by_access_percentage_total"),
+		"The number of queries which used an index by the percentage of the rows of the table they selected, summed over the TiDB servers.",
+		[]string{"schema", "table", "index", "percentage"}, nil)
+	indexUsageLastAccessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "index_usage_last_access_age_seconds"),
+		"The time since an index was last used on any TiDB server.",
+		[]string{"schema", "table", "index"}, nil)
+	unusedIndexesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "unused_indexes"),
+		"The number of indexes which have not been used, by schema.",
+		[]string{"schema"}, nil)
+)
+
+// ScrapeIndexUsage collects from `information_schema.cluster_tidb_index_usage` and `sys.schema_unused_indexes`.
+type ScrapeIndexUsage struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeIndexUsage) Name() string {
+	return informationSchema + ".index_usage"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeIndexUsage) Help() string {
+	return "Collect index usage from information_schema.cluster_tidb_index_usage and unused indexes from sys.schema_unused_indexes"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeIndexUsage) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeIndexUsage) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	filter := newListFilter(*indexUsageTables)
+
+	// The usage rows are closed before the unused indexes are queried on the same connection.
+	if err := scrapeIndexUsage(ctx, db, ch, filter); err != nil {
+		return err
+	}
+	return scrapeUnusedIndexes(ctx, db, ch, filter)
+}
+
+func scrapeIndexUsage(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, filter listFilter) error {
+	usageRows, err := db.QueryContext(ctx, infoSchemaIndexUsageQuery)
+	if err != nil {
+		return err
+	}
+	defer usageRows.Close()
+
+	var (
+		database      string
+		table         string
+		index         string
+		queries       float64
+		access        = make([]float64, len(indexUsageAccessBuckets))
+		lastAccessAge sql.NullFloat64
+		exported      int
+	)
+	scanArgs := []interface{}{&database, &table, &index, &queries}
+	for i := range access {
+		scanArgs = append(scanArgs, &access[i])
+	}
+	scanArgs = append(scanArgs, &lastAccessAge)
+	for usageRows.Next() {
+		if exported >= *indexUsageLimit {
+			break
+		}
+		if err := usageRows.Scan(scanArgs...); err != nil {
+			return err
+		}
+		if !filter.matches(database + "." + table) {
+			continue
+		}
+		exported++
+
+		ch <- prometheus.MustNewConstMetric(indexUsageQueriesDesc, prometheus.CounterValue, queries, database, table, index)
+		for i, bucket := range indexUsageAccessBuckets {
+			ch <- prometheus.MustNewConstMetric(indexUsageAccessDesc, prometheus.CounterValue, access[i], database, table, index, bucket)
+		}
+		if lastAccessAge.Valid {
+			ch <- prometheus.MustNewConstMetric(indexUsageLastAccessDesc, prometheus.GaugeValue, lastAccessAge.Float64, database, table, index)
+		}
+	}
+	return usageRows.Err()
+}
+
+func scrapeUnusedIndexes(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, filter listFilter) error {
+	unusedRows, err := db.QueryContext(ctx, sysUnusedIndexesQuery)
+	if err != nil {
+		return err
+	}
+	defer unusedRows.Close()
+
+	var (
+		database string
+		table    string
+	)
+	unused := make(map[string]float64)
+	for unusedRows.Next() {
+		if err := unusedRows.Scan(&database, &table); err != nil {
+			return err
+		}
+		if filter.matches(database + "." + table) {
+			unused[database]++
+		}
+	}
+	if err := unusedRows.Err(); err != nil {
+		return err
+	}
+
+	for _, database := range sortedMapKeys(unused) {
+		ch <- prometheus.MustNewConstMetric(unusedIndexesDesc, prometheus.GaugeValue, unused[database], database)
+	}
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeIndexUsage{}
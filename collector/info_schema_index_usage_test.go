@@ -0,0 +1,97 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeIndexUsage(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.index_usage.tables=shop.orders,shop.items",
+		"--collect.info_schema.index_usage.limit=2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+	// The exporter uses a single connection, the usage rows must be closed before the unused indexes are queried.
+	db.SetMaxOpenConns(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	columns := []string{"TABLE_SCHEMA", "TABLE_NAME", "INDEX_NAME", "QUERY_TOTAL",
+		"PERCENTAGE_ACCESS_0", "PERCENTAGE_ACCESS_0_1", "PERCENTAGE_ACCESS_1_10", "PERCENTAGE_ACCESS_10_20",
+		"PERCENTAGE_ACCESS_20_50", "PERCENTAGE_ACCESS_50_100", "PERCENTAGE_ACCESS_100", "LAST_ACCESS_AGE"}
+	mock.ExpectQuery(sanitizeQuery(infoSchemaIndexUsageQuery)).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow("shop", "carts", "PRIMARY", 250000, 0, 250000, 0, 0, 0, 0, 0, 1).
+		AddRow("shop", "items", "PRIMARY", 90000, 0, 90000, 0, 0, 0, 0, 0, 1).
+		AddRow("shop", "orders", "idx_user", 1200, 10, 1000, 150, 30, 10, 0, 0, 5).
+		AddRow("shop", "orders", "idx_legacy", 0, 0, 0, 0, 0, 0, 0, 0, nil))
+	mock.ExpectQuery(sanitizeQuery(sysUnusedIndexesQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"object_schema", "object_name"}).
+			AddRow("shop", "orders").
+			AddRow("shop", "carts"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeIndexUsage{}).Scrape(ctx, db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"schema": "shop", "table": "items", "index": "PRIMARY"}, value: 90000, metricType: dto.MetricType_COUNTER},
+	}
+	for i, value := range []float64{0, 90000, 0, 0, 0, 0, 0} {
+		expected = append(expected, MetricResult{labels: labelMap{"schema": "shop", "table": "items", "index": "PRIMARY", "percentage": indexUsageAccessBuckets[i]}, value: value, metricType: dto.MetricType_COUNTER})
+	}
+	expected = append(expected, MetricResult{labels: labelMap{"schema": "shop", "table": "items", "index": "PRIMARY"}, value: 1, metricType: dto.MetricType_GAUGE})
+	expected = append(expected, MetricResult{labels: labelMap{"schema": "shop", "table": "orders", "index": "idx_user"}, value: 1200, metricType: dto.MetricType_COUNTER})
+	for i, value := range []float64{10, 1000, 150, 30, 10, 0, 0} {
+		expected = append(expected, MetricResult{labels: labelMap{"schema": "shop", "table": "orders", "index": "idx_user", "percentage": indexUsageAccessBuckets[i]}, value: value, metricType: dto.MetricType_COUNTER})
+	}
+	expected = append(expected,
+		MetricResult{labels: labelMap{"schema": "shop", "table": "orders", "index": "idx_user"}, value: 5, metricType: dto.MetricType_GAUGE},
+		MetricResult{labels: labelMap{"schema": "shop"}, value: 1, metricType: dto.MetricType_GAUGE},
+	)
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.inspection_result`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	inspectionNowQuery = `SELECT NOW()`
+	// The time range hint sets the window the rules inspect, it defaults to the last 10 minutes.
+	infoSchemaInspectionResultQuery = `
+		  SELECT /*+ time_range('%s', '%s') */
+		    RULE,
+		    ITEM,
+		    TYPE,
+		    ifnull(INSTANCE, '') as INSTANCE,
+		    SEVERITY
+		  FROM information_schema.inspection_result
+		`
+	inspectionTimeLayout = "2006-01-02 15:04:05"
+)
+
+// Tunable flags.
+var (
+	inspectionResultWindow = kingpin.Flag(
+		"collect.info_schema.inspection_result.window",
+		"The time window in seconds before the scrape over which the diagnosis rules are inspected",
+	).Default("600").Int()
+)
+
+// Severities of inspection results which are always exported.
+var inspectionSeverities = []string{"warning", "critical"}
+
+// Metric descriptors.
+var (
+	inspectionResultDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "inspection_result"),
+		"The number of findings of a diagnosis rule for an item, type and instance.",
+		[]string{"rule", "item", "type", "instance", "severity"}, nil)
+	inspectionResultsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "inspection_results"),
+		"The number of findings of the diagnosis rules by severity.",
+		[]string{"severity"}, nil)
+)
+
+// ScrapeInspectionResult collects from `information_schema.inspection_result`.
+type ScrapeInspectionResult struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeInspectionResult) Name() string {
+	return informationSchema + ".inspection_result"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeInspectionResult) Help() string {
+	return "Collect findings of the cluster diagnosis rules from information_schema.inspection_result"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeInspectionResult) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeInspectionResult) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	// The window is computed from the time of the server, whatever its time zone.
+	var now string
+	if err := db.QueryRowContext(ctx, inspectionNowQuery).Scan(&now); err != nil {
+		return err
+	}
+	end, err := time.Parse(inspectionTimeLayout, now)
+	if err != nil {
+		return err
+	}
+	start := end.Add(-time.Duration(*inspectionResultWindow) * time.Second)
+
+	inspectionRows, err := db.QueryContext(ctx, fmt.Sprintf(infoSchemaInspectionResultQuery,
+		start.Format(inspectionTimeLayout), end.Format(inspectionTimeLayout)))
+	if err != nil {
+		return err
+	}
+	defer inspectionRows.Close()
+
+	var (
+		rule     string
+		item     string
+		itemType string
+		instance string
+		severity string
+	)
+	// A rule may report an item several times for an instance, such as for each of its status addresses.
+	findings := make(map[[5]string]float64)
+	var order [][5]string
+	severities := make(map[string]float64)
+	for _, s := range inspectionSeverities {
+		severities[s] = 0
+	}
+	for inspectionRows.Next() {
+		if err := inspectionRows.Scan(&rule, &item, &itemType, &instance, &severity); err != nil {
+			return err
+		}
+		severity = strings.ToLower(severity)
+		key := [5]string{rule, item, itemType, instance, severity}
+		if _, ok := findings[key]; !ok {
+			order = append(order, key)
+		}
+		findings[key]++
+		severities[severity]++
+	}
+	if err := inspectionRows.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range order {
+		ch <- prometheus.MustNewConstMetric(inspectionResultDesc, prometheus.GaugeValue, findings[key], key[:]...)
+	}
+	for _, s := range sortedMapKeys(severities) {
+		ch <- prometheus.MustNewConstMetric(inspectionResultsDesc, prometheus.GaugeValue, severities[s], s)
+	}
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeInspectionResult{}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeInspectionResult(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{"--collect.info_schema.inspection_result.window=3600"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(sanitizeQuery(inspectionNowQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"NOW()"}).AddRow("2026-10-16 00:30:00"))
+	// The optimizer hint is not escaped by sanitizeQuery.
+	inspectionQuery := fmt.Sprintf(infoSchemaInspectionResultQuery, "2026-10-15 23:30:00", "2026-10-16 00:30:00")
+	mock.ExpectQuery(regexp.QuoteMeta(strings.Join(strings.Fields(inspectionQuery), " "))).WillReturnRows(
+		sqlmock.NewRows([]string{"RULE", "ITEM", "TYPE", "INSTANCE", "SEVERITY"}).
+			AddRow("config", "log.slow-threshold", "tidb", "", "warning").
+			AddRow("version", "git_hash", "tikv", "", "critical").
+			AddRow("threshold-check", "cpu-usage", "tikv", "10.0.2.1:20160", "warning").
+			AddRow("threshold-check", "cpu-usage", "tikv", "10.0.2.1:20160", "warning"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeInspectionResult{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"rule": "config", "item": "log.slow-threshold", "type": "tidb", "instance": "", "severity": "warning"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"rule": "version", "item": "git_hash", "type": "tikv", "instance": "", "severity": "critical"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"rule": "threshold-check", "item": "cpu-usage", "type": "tikv", "instance": "10.0.2.1:20160", "severity": "warning"}, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"severity": "critical"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"severity": "warning"}, value: 3, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.data_lock_waits` and `information_schema.cluster_deadlocks`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Lock waits have no instance, it is taken from the waiting transaction.
+	infoSchemaDataLockWaitsQuery = `
+		  SELECT
+		    ifnull(trx.INSTANCE, '') as INSTANCE,
+		    ifnull(waits.SQL_DIGEST, '') as SQL_DIGEST,
+		    COUNT(*) as WAITERS,
+		    ifnull(MAX(TIMESTAMPDIFF(MICROSECOND, trx.WAITING_START_TIME, NOW(6))), 0) as MAX_WAIT
+		  FROM information_schema.data_lock_waits waits
+		  LEFT JOIN information_schema.cluster_tidb_trx trx ON waits.TRX_ID = trx.ID
+		  GROUP BY INSTANCE, SQL_DIGEST
+		`
+	// A deadlock has a row for each transaction in the cycle.
+	infoSchemaClusterDeadlocksQuery = `
+		  SELECT
+		    INSTANCE,
+		    DEADLOCK_ID,
+		    MIN(OCCUR_TIME) as OCCUR_TIME
+		  FROM information_schema.cluster_deadlocks
+		  GROUP BY INSTANCE, DEADLOCK_ID
+		  ORDER BY INSTANCE, OCCUR_TIME, DEADLOCK_ID
+		`
+)
+
+// Metric descriptors.
+var (
+	lockWaitsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "lock_waits"),
+		"The number of transactions waiting for a pessimistic lock by instance and statement digest.",
+		[]string{"instance", "digest"}, nil)
+	lockWaitMaxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "lock_wait_max_seconds"),
+		"The longest current wait for a pessimistic lock by instance and statement digest.",
+		[]string{"instance", "digest"}, nil)
+)
+
+// deadlockCursor is the last deadlock counted for an instance.
+type deadlockCursor struct {
+	occurTime  string
+	deadlockID int64
+}
+
+// after reports whether the deadlock occurred after the cursor, deadlock ids restart with the instance.
+func (c deadlockCursor) after(occurTime string, deadlockID int64) bool {
+	return occurTime > c.occurTime || occurTime == c.occurTime && deadlockID > c.deadlockID
+}
+
+// lockContentionState is kept between scrapes of a target.
+type lockContentionState struct {
+	mu          sync.Mutex
+	initialized bool
+	cursors     map[string]deadlockCursor
+	deadlocks   *prometheus.CounterVec
+}
+
+func newLockContentionState() interface{} {
+	return &lockContentionState{
+		cursors: make(map[string]deadlockCursor),
+		deadlocks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "deadlocks_total",
+			Help:      "The number of deadlocks by instance.",
+		}, []string{"instance"}),
+	}
+}
+
+// ScrapeLockContention collects from `information_schema.data_lock_waits` and `information_schema.cluster_deadlocks`.
+type ScrapeLockContention struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeLockContention) Name() string {
+	return informationSchema + ".lock_contention"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeLockContention) Help() string {
+	return "Collect pessimistic lock waits and deadlocks from information_schema.data_lock_waits and information_schema.cluster_deadlocks"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeLockContention) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+// Without state kept between scrapes, no deadlocks are counted.
+func (s ScrapeLockContention) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Each deadlock which occurs after the first scrape of the target is counted once.
+func (s ScrapeLockContention) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	if err := scrapeDataLockWaits(ctx, db, ch); err != nil {
+		return err
+	}
+
+	state := targetState.Load(s.Name(), newLockContentionState).(*lockContentionState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if err := state.readDeadlocks(ctx, db); err != nil {
+		return err
+	}
+	state.deadlocks.Collect(ch)
+	return nil
+}
+
+func scrapeDataLockWaits(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric) error {
+	lockWaitsRows, err := db.QueryContext(ctx, infoSchemaDataLockWaitsQuery)
+	if err != nil {
+		return err
+	}
+	defer lockWaitsRows.Close()
+
+	var (
+		instance string
+		digest   string
+		waiters  float64
+		maxWait  float64
+	)
+	for lockWaitsRows.Next() {
+		if err := lockWaitsRows.Scan(&instance, &digest, &waiters, &maxWait); err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(lockWaitsDesc, prometheus.GaugeValue, waiters, instance, digest)
+		ch <- prometheus.MustNewConstMetric(lockWaitMaxDesc, prometheus.GaugeValue, maxWait/1e6, instance, digest)
+	}
+	return lockWaitsRows.Err()
+}
+
+func (state *lockContentionState) readDeadlocks(ctx context.Context, db *sql.DB) error {
+	deadlockRows, err := db.QueryContext(ctx, infoSchemaClusterDeadlocksQuery)
+	if err != nil {
+		return err
+	}
+	defer deadlockRows.Close()
+
+	var (
+		instance   string
+		deadlockID int64
+		occurTime  string
+	)
+	for deadlockRows.Next() {
+		if err := deadlockRows.Scan(&instance, &deadlockID, &occurTime); err != nil {
+			return err
+		}
+		cursor, ok := state.cursors[instance]
+		if ok && !cursor.after(occurTime, deadlockID) {
+			continue
+		}
+		state.cursors[instance] = deadlockCursor{occurTime: occurTime, deadlockID: deadlockID}
+		// Deadlocks in the history at the first scrape are not counted,
+		// all of an instance seen later occurred after it.
+		counter := state.deadlocks.WithLabelValues(instance)
+		if state.initialized {
+			counter.Inc()
+		}
+	}
+	if err := deadlockRows.Err(); err != nil {
+		return err
+	}
+	state.initialized = true
+	return nil
+}
+
+// check interface
+var _ StatefulScraper = ScrapeLockContention{}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeLockContention(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	lockWaitsColumns := []string{"INSTANCE", "SQL_DIGEST", "WAITERS", "MAX_WAIT"}
+	deadlocksColumns := []string{"INSTANCE", "DEADLOCK_ID", "OCCUR_TIME"}
+
+	mock.ExpectQuery(sanitizeQuery(infoSchemaDataLockWaitsQuery)).WillReturnRows(sqlmock.NewRows(lockWaitsColumns).
+		AddRow("10.0.1.1:10080", "e6f07d43", 3, 2500000))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterDeadlocksQuery)).WillReturnRows(sqlmock.NewRows(deadlocksColumns).
+		AddRow("10.0.1.1:10080", 7, "2026-10-16 09:00:00.000000"))
+
+	mock.ExpectQuery(sanitizeQuery(infoSchemaDataLockWaitsQuery)).WillReturnRows(sqlmock.NewRows(lockWaitsColumns))
+	// The first instance restarted and reuses deadlock ids, the second one is new.
+	mock.ExpectQuery(sanitizeQuery(infoSchemaClusterDeadlocksQuery)).WillReturnRows(sqlmock.NewRows(deadlocksColumns).
+		AddRow("10.0.1.1:10080", 7, "2026-10-16 09:00:00.000000").
+		AddRow("10.0.1.1:10080", 1, "2026-10-16 10:00:00.000000").
+		AddRow("10.0.1.1:10080", 2, "2026-10-16 10:00:00.000000").
+		AddRow("10.0.1.2:10080", 1, "2026-10-16 10:00:01.000000"))
+
+	state := NewState().Target("")
+	expected := [][]MetricResult{
+		{
+			{labels: labelMap{"instance": "10.0.1.1:10080", "digest": "e6f07d43"}, value: 3, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"instance": "10.0.1.1:10080", "digest": "e6f07d43"}, value: 2.5, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"instance": "10.0.1.1:10080"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "10.0.1.1:10080"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "10.0.1.2:10080"}, value: 1, metricType: dto.MetricType_COUNTER},
+		},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, scrape := range expected {
+			ch := make(chan prometheus.Metric)
+			go func() {
+				if err := (ScrapeLockContention{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+					t.Errorf("error calling function on test: %s", err)
+				}
+				close(ch)
+			}()
+			got := []MetricResult{}
+			for m := range ch {
+				got = append(got, readMetric(m))
+			}
+			// Counters are collected in no particular order.
+			convey.So(got, convey.ShouldHaveLength, len(scrape))
+			for _, expect := range scrape {
+				convey.So(got, convey.ShouldContain, expect)
+			}
+		}
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
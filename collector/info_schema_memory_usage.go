@@ -0,0 +1,263 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_memory_usage` and `information_schema.cluster_memory_usage_ops_history`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	infoSchemaMemoryUsageQuery = `
+		  SELECT
+		    INSTANCE,
+		    MEMORY_TOTAL,
+		    MEMORY_LIMIT,
+		    MEMORY_CURRENT,
+		    MEMORY_MAX_USED,
+		    ifnull(CURRENT_OPS, '') as CURRENT_OPS,
+		    GC_TOTAL,
+		    SESSION_KILL_TOTAL
+		  FROM information_schema.cluster_memory_usage
+		`
+	infoSchemaMemoryUsageOpsHistoryQuery = `
+		  SELECT
+		    INSTANCE,
+		    TIME,
+		    OPS,
+		    PROCESSID,
+		    ifnull(SQL_DIGEST, '') as SQL_DIGEST
+		  FROM information_schema.cluster_memory_usage_ops_history
+		  ORDER BY INSTANCE, TIME
+		`
+)
+
+// Tunable flags.
+var (
+	memoryUsageDigestLimit = kingpin.Flag(
+		"collect.info_schema.memory_usage.digest_limit",
+		"Maximum number of digests to count memory actions for, the most frequent ones recently, actions on queries of further digests are counted with an empty digest",
+	).Default("20").Int()
+	memoryUsageDigestWindow = kingpin.Flag(
+		"collect.info_schema.memory_usage.digest_window",
+		"Window in seconds over which digests are ranked for the digest limit, digests are ranked by their memory actions in the current and the previous window",
+	).Default("3600").Int()
+)
+
+// Metric descriptors.
+var (
+	memoryTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_total_bytes"),
+		"The total memory available to an instance.",
+		[]string{"instance"}, nil)
+	memoryLimitDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_limit_bytes"),
+		"The memory limit of an instance, 0 when there is none.",
+		[]string{"instance"}, nil)
+	memoryCurrentDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_heap_inuse_bytes"),
+		"The heap memory currently in use by an instance.",
+		[]string{"instance"}, nil)
+	memoryMaxUsedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_max_used_bytes"),
+		"The maximum heap memory used by an instance.",
+		[]string{"instance"}, nil)
+	memoryCurrentOpsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_arbitration_info"),
+		"The memory operation an instance is performing to stay within its limit, empty when there is none, the value is always 1.",
+		[]string{"instance", "ops"}, nil)
+	memoryGCTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_limit_gc_total"),
+		"The number of GCs an instance triggered to stay within its memory limit.",
+		[]string{"instance"}, nil)
+	memorySessionKillTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "memory_limit_session_kills_total"),
+		"The number of sessions an instance killed to stay within its memory limit.",
+		[]string{"instance"}, nil)
+)
+
+// memoryOpsCursor is the time of the last memory action counted for an instance, and the actions counted at that time.
+type memoryOpsCursor struct {
+	time string
+	seen map[string]bool
+}
+
+// memoryUsageState is kept between scrapes of a target.
+type memoryUsageState struct {
+	mu          sync.Mutex
+	initialized bool
+	cursors     map[string]*memoryOpsCursor
+	// Digests which are counted separately.
+	digests *topDigests
+
+	ops       *prometheus.CounterVec
+	digestOps *prometheus.CounterVec
+}
+
+func newMemoryUsageState() interface{} {
+	return &memoryUsageState{
+		cursors: make(map[string]*memoryOpsCursor),
+		digests: newTopDigests(),
+		ops: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "memory_ops_total",
+			Help:      "The number of memory actions, such as killing a query, by instance and operation.",
+		}, []string{"instance", "ops"}),
+		digestOps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "memory_ops_by_digest_total",
+			Help:      "The number of memory actions by operation and digest of the query acted on.",
+		}, []string{"ops", "digest"}),
+	}
+}
+
+// ScrapeMemoryUsage collects from `information_schema.cluster_memory_usage`.
+type ScrapeMemoryUsage struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeMemoryUsage) Name() string {
+	return informationSchema + ".memory_usage"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeMemoryUsage) Help() string {
+	return "Collect memory limit and usage per instance from information_schema.cluster_memory_usage and count memory actions from information_schema.cluster_memory_usage_ops_history"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeMemoryUsage) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+// Without state kept between scrapes, no memory actions are counted.
+func (s ScrapeMemoryUsage) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Each memory action taken after the first scrape of the target is counted once.
+func (s ScrapeMemoryUsage) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	if err := scrapeMemoryUsage(ctx, db, ch); err != nil {
+		return err
+	}
+
+	state := targetState.Load(s.Name(), newMemoryUsageState).(*memoryUsageState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if err := state.readMemoryOps(ctx, db); err != nil {
+		return err
+	}
+	state.ops.Collect(ch)
+	state.digestOps.Collect(ch)
+	return nil
+}
+
+func scrapeMemoryUsage(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric) error {
+	memoryRows, err := db.QueryContext(ctx, infoSchemaMemoryUsageQuery)
+	if err != nil {
+		return err
+	}
+	defer memoryRows.Close()
+
+	var (
+		instance     string
+		total        float64
+		limit        float64
+		current      float64
+		maxUsed      float64
+		currentOps   string
+		gcTotal      float64
+		sessionKills float64
+	)
+	for memoryRows.Next() {
+		if err := memoryRows.Scan(&instance, &total, &limit, &current, &maxUsed, &currentOps, &gcTotal, &sessionKills); err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(memoryTotalDesc, prometheus.GaugeValue, total, instance)
+		ch <- prometheus.MustNewConstMetric(memoryLimitDesc, prometheus.GaugeValue, limit, instance)
+		ch <- prometheus.MustNewConstMetric(memoryCurrentDesc, prometheus.GaugeValue, current, instance)
+		ch <- prometheus.MustNewConstMetric(memoryMaxUsedDesc, prometheus.GaugeValue, maxUsed, instance)
+		ch <- prometheus.MustNewConstMetric(memoryCurrentOpsDesc, prometheus.GaugeValue, 1, instance, currentOps)
+		ch <- prometheus.MustNewConstMetric(memoryGCTotalDesc, prometheus.CounterValue, gcTotal, instance)
+		ch <- prometheus.MustNewConstMetric(memorySessionKillTotalDesc, prometheus.CounterValue, sessionKills, instance)
+	}
+	return memoryRows.Err()
+}
+
+func (state *memoryUsageState) readMemoryOps(ctx context.Context, db *sql.DB) error {
+	opsRows, err := db.QueryContext(ctx, infoSchemaMemoryUsageOpsHistoryQuery)
+	if err != nil {
+		return err
+	}
+	defer opsRows.Close()
+
+	var (
+		instance  string
+		opsTime   string
+		ops       string
+		processID string
+		digest    string
+	)
+	for opsRows.Next() {
+		if err := opsRows.Scan(&instance, &opsTime, &ops, &processID, &digest); err != nil {
+			return err
+		}
+		id := processID + "/" + digest
+		cursor, ok := state.cursors[instance]
+		if ok && (opsTime < cursor.time || opsTime == cursor.time && cursor.seen[id]) {
+			continue
+		}
+		if !ok || opsTime != cursor.time {
+			cursor = &memoryOpsCursor{time: opsTime, seen: make(map[string]bool)}
+			state.cursors[instance] = cursor
+		}
+		cursor.seen[id] = true
+
+		// Actions in the history at the first scrape are not counted,
+		// all of an instance seen later were taken after it.
+		counter := state.ops.WithLabelValues(instance, ops)
+		if !state.initialized {
+			continue
+		}
+		counter.Inc()
+
+		state.digestOps.WithLabelValues(ops, state.digests.observe(digest, *memoryUsageDigestLimit)).Inc()
+	}
+	if err := opsRows.Err(); err != nil {
+		return err
+	}
+
+	// Digests which are no longer among the most frequent are counted with an empty digest from now on.
+	window := time.Duration(*memoryUsageDigestWindow) * time.Second
+	for _, digest := range state.digests.update(time.Now(), window, *memoryUsageDigestLimit) {
+		state.digestOps.DeletePartialMatch(prometheus.Labels{"digest": digest})
+	}
+	state.initialized = true
+	return nil
+}
+
+// check interface
+var _ StatefulScraper = ScrapeMemoryUsage{}
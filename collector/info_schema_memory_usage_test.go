@@ -0,0 +1,121 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeMemoryUsage(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{"--collect.info_schema.memory_usage.digest_limit=1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	usageColumns := []string{"INSTANCE", "MEMORY_TOTAL", "MEMORY_LIMIT", "MEMORY_CURRENT", "MEMORY_MAX_USED",
+		"CURRENT_OPS", "GC_TOTAL", "SESSION_KILL_TOTAL"}
+	opsColumns := []string{"INSTANCE", "TIME", "OPS", "PROCESSID", "SQL_DIGEST"}
+
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageQuery)).WillReturnRows(sqlmock.NewRows(usageColumns).
+		AddRow("10.0.1.1:10080", 34359738368, 27487790694, 20000000000, 26000000000, "shrink", 12, 3))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageOpsHistoryQuery)).WillReturnRows(sqlmock.NewRows(opsColumns).
+		AddRow("10.0.1.1:10080", "2026-10-16 09:00:00.000000", "SessionKill", 101, "e6f07d43"))
+
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageQuery)).WillReturnRows(sqlmock.NewRows(usageColumns))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageOpsHistoryQuery)).WillReturnRows(sqlmock.NewRows(opsColumns).
+		AddRow("10.0.1.1:10080", "2026-10-16 09:00:00.000000", "SessionKill", 101, "e6f07d43").
+		AddRow("10.0.1.1:10080", "2026-10-16 10:00:00.000000", "SessionKill", 102, "a3c2f190").
+		AddRow("10.0.1.1:10080", "2026-10-16 10:00:00.000000", "SessionKill", 103, "e6f07d43").
+		AddRow("10.0.1.2:10080", "2026-10-16 10:00:01.000000", "SessionKill", 7, "a3c2f190"))
+
+	// A digest becoming more frequent than the tracked one replaces it after the scrape.
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageQuery)).WillReturnRows(sqlmock.NewRows(usageColumns))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageOpsHistoryQuery)).WillReturnRows(sqlmock.NewRows(opsColumns).
+		AddRow("10.0.1.1:10080", "2026-10-16 10:00:02.000000", "SessionKill", 104, "e6f07d43").
+		AddRow("10.0.1.1:10080", "2026-10-16 10:00:02.000000", "SessionKill", 105, "e6f07d43"))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageQuery)).WillReturnRows(sqlmock.NewRows(usageColumns))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaMemoryUsageOpsHistoryQuery)).WillReturnRows(sqlmock.NewRows(opsColumns).
+		AddRow("10.0.1.1:10080", "2026-10-16 10:00:03.000000", "SessionKill", 106, "e6f07d43"))
+
+	instance := labelMap{"instance": "10.0.1.1:10080"}
+	state := NewState().Target("")
+	expected := [][]MetricResult{
+		{
+			{labels: instance, value: 34359738368, metricType: dto.MetricType_GAUGE},
+			{labels: instance, value: 27487790694, metricType: dto.MetricType_GAUGE},
+			{labels: instance, value: 20000000000, metricType: dto.MetricType_GAUGE},
+			{labels: instance, value: 26000000000, metricType: dto.MetricType_GAUGE},
+			{labels: labelMap{"instance": "10.0.1.1:10080", "ops": "shrink"}, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: instance, value: 12, metricType: dto.MetricType_COUNTER},
+			{labels: instance, value: 3, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "10.0.1.1:10080", "ops": "SessionKill"}, value: 0, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "10.0.1.1:10080", "ops": "SessionKill"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "10.0.1.2:10080", "ops": "SessionKill"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"ops": "SessionKill", "digest": "a3c2f190"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"ops": "SessionKill", "digest": ""}, value: 1, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "10.0.1.1:10080", "ops": "SessionKill"}, value: 4, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "10.0.1.2:10080", "ops": "SessionKill"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"ops": "SessionKill", "digest": ""}, value: 3, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "10.0.1.1:10080", "ops": "SessionKill"}, value: 5, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "10.0.1.2:10080", "ops": "SessionKill"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"ops": "SessionKill", "digest": "e6f07d43"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"ops": "SessionKill", "digest": ""}, value: 3, metricType: dto.MetricType_COUNTER},
+		},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, scrape := range expected {
+			ch := make(chan prometheus.Metric)
+			go func() {
+				if err := (ScrapeMemoryUsage{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+					t.Errorf("error calling function on test: %s", err)
+				}
+				close(ch)
+			}()
+			got := []MetricResult{}
+			for m := range ch {
+				got = append(got, readMetric(m))
+			}
+			// Counters are collected in no particular order.
+			convey.So(got, convey.ShouldHaveLength, len(scrape))
+			for _, expect := range scrape {
+				convey.So(got, convey.ShouldContain, expect)
+			}
+		}
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
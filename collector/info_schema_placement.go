@@ -0,0 +1,178 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.placement_policies` and `SHOW PLACEMENT`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Objects attached to each policy, with a row without type for each policy.
+	infoSchemaPlacementPoliciesQuery = `
+		  SELECT POLICY_NAME, '' as OBJECT_TYPE, 0 as OBJECTS
+		  FROM information_schema.placement_policies
+		  UNION ALL
+		  SELECT TIDB_PLACEMENT_POLICY_NAME, 'database', COUNT(*)
+		  FROM information_schema.schemata
+		  WHERE TIDB_PLACEMENT_POLICY_NAME IS NOT NULL
+		  GROUP BY TIDB_PLACEMENT_POLICY_NAME
+		  UNION ALL
+		  SELECT TIDB_PLACEMENT_POLICY_NAME, 'table', COUNT(*)
+		  FROM information_schema.tables
+		  WHERE TIDB_PLACEMENT_POLICY_NAME IS NOT NULL
+		  GROUP BY TIDB_PLACEMENT_POLICY_NAME
+		  UNION ALL
+		  SELECT TIDB_PLACEMENT_POLICY_NAME, 'partition', COUNT(*)
+		  FROM information_schema.partitions
+		  WHERE TIDB_PLACEMENT_POLICY_NAME IS NOT NULL
+		  GROUP BY TIDB_PLACEMENT_POLICY_NAME
+		`
+	showPlacementQuery = `SHOW PLACEMENT`
+)
+
+// Types of objects a policy can be attached to.
+var placementObjectTypes = []string{"database", "table", "partition"}
+
+// Scheduling states of a placement, exported as an enum.
+var placementSchedulingStates = []string{"SCHEDULED", "INPROGRESS", "PENDING"}
+
+// Metric descriptors.
+var (
+	placementPolicyObjectsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "placement_policy_objects"),
+		"The number of databases, tables and partitions a placement policy is attached to.",
+		[]string{"policy", "type"}, nil)
+	placementSchedulingStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "placement_scheduling_state"),
+		"The scheduling state of the placement of a table or partition, 1 for the current state and 0 for the others.",
+		[]string{"schema", "table", "partition", "state"}, nil)
+)
+
+// ScrapePlacement collects from `information_schema.placement_policies` and `SHOW PLACEMENT`.
+type ScrapePlacement struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapePlacement) Name() string {
+	return informationSchema + ".placement"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapePlacement) Help() string {
+	return "Collect objects per placement policy and scheduling state of tables and partitions from information_schema.placement_policies and SHOW PLACEMENT"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapePlacement) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapePlacement) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	if err := scrapePlacementPolicies(ctx, db, ch); err != nil {
+		return err
+	}
+
+	placementRows, err := db.QueryContext(ctx, showPlacementQuery)
+	if err != nil {
+		return err
+	}
+	defer placementRows.Close()
+
+	var (
+		target    string
+		placement string
+		state     string
+	)
+	for placementRows.Next() {
+		if err := placementRows.Scan(&target, &placement, &state); err != nil {
+			return err
+		}
+		database, table, partition, ok := parsePlacementTarget(target)
+		if !ok {
+			continue
+		}
+
+		known := false
+		for _, s := range placementSchedulingStates {
+			value := 0.0
+			if s == state {
+				value, known = 1, true
+			}
+			ch <- prometheus.MustNewConstMetric(placementSchedulingStateDesc, prometheus.GaugeValue, value, database, table, partition, s)
+		}
+		if !known {
+			ch <- prometheus.MustNewConstMetric(placementSchedulingStateDesc, prometheus.GaugeValue, 1, database, table, partition, state)
+		}
+	}
+	return placementRows.Err()
+}
+
+func scrapePlacementPolicies(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric) error {
+	policyRows, err := db.QueryContext(ctx, infoSchemaPlacementPoliciesQuery)
+	if err != nil {
+		return err
+	}
+	defer policyRows.Close()
+
+	var (
+		policy     string
+		objectType string
+		objects    float64
+	)
+	policies := make(map[string]map[string]float64)
+	for policyRows.Next() {
+		if err := policyRows.Scan(&policy, &objectType, &objects); err != nil {
+			return err
+		}
+		if policies[policy] == nil {
+			policies[policy] = make(map[string]float64)
+		}
+		if objectType != "" {
+			policies[policy][objectType] = objects
+		}
+	}
+	if err := policyRows.Err(); err != nil {
+		return err
+	}
+
+	for _, policy := range sortedMapKeys(policies) {
+		for _, objectType := range placementObjectTypes {
+			ch <- prometheus.MustNewConstMetric(placementPolicyObjectsDesc, prometheus.GaugeValue, policies[policy][objectType], policy, objectType)
+		}
+	}
+	return nil
+}
+
+// parsePlacementTarget parses a table target of SHOW PLACEMENT, such as "TABLE db.t PARTITION p".
+func parsePlacementTarget(target string) (database, table, partition string, ok bool) {
+	fields := strings.Fields(strings.ReplaceAll(target, "`", ""))
+	if len(fields) < 2 || fields[0] != "TABLE" {
+		return "", "", "", false
+	}
+	database, table, ok = strings.Cut(fields[1], ".")
+	if len(fields) >= 4 && fields[2] == "PARTITION" {
+		partition = fields[3]
+	}
+	return database, table, partition, ok
+}
+
+// check interface
+var _ Scraper = ScrapePlacement{}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapePlacement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(sanitizeQuery(infoSchemaPlacementPoliciesQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"POLICY_NAME", "OBJECT_TYPE", "OBJECTS"}).
+			AddRow("eu", "", 0).
+			AddRow("us", "", 0).
+			AddRow("eu", "database", 1).
+			AddRow("eu", "table", 3).
+			AddRow("eu", "partition", 12))
+	mock.ExpectQuery(sanitizeQuery(showPlacementQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"Target", "Placement", "Scheduling_State"}).
+			AddRow("POLICY eu", `PRIMARY_REGION="eu-west-1" REGIONS="eu-west-1"`, "NULL").
+			AddRow("DATABASE tenant_eu", `PRIMARY_REGION="eu-west-1" REGIONS="eu-west-1"`, "SCHEDULED").
+			AddRow("TABLE tenant_eu.orders", `PRIMARY_REGION="eu-west-1" REGIONS="eu-west-1"`, "SCHEDULED").
+			AddRow("TABLE tenant_eu.events PARTITION p202610", `PRIMARY_REGION="eu-west-1" REGIONS="eu-west-1"`, "PENDING"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapePlacement{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{"policy": "eu", "type": "database"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"policy": "eu", "type": "table"}, value: 3, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"policy": "eu", "type": "partition"}, value: 12, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"policy": "us", "type": "database"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"policy": "us", "type": "table"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"policy": "us", "type": "partition"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "orders", "partition": "", "state": "SCHEDULED"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "orders", "partition": "", "state": "INPROGRESS"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "orders", "partition": "", "state": "PENDING"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "events", "partition": "p202610", "state": "SCHEDULED"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "events", "partition": "p202610", "state": "INPROGRESS"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "tenant_eu", "table": "events", "partition": "p202610", "state": "PENDING"}, value: 1, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
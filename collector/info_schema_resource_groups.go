@@ -0,0 +1,168 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.resource_groups`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const infoSchemaResourceGroupsQuery = `
+		  SELECT
+		    NAME,
+		    RU_PER_SEC,
+		    PRIORITY,
+		    BURSTABLE,
+		    ifnull(QUERY_LIMIT, '') as QUERY_LIMIT
+		  FROM information_schema.resource_groups
+		`
+
+// Priorities a resource group can have, exported as an enum.
+var resourceGroupPriorities = []string{"LOW", "MEDIUM", "HIGH"}
+
+// Settings of QUERY_LIMIT, such as "EXEC_ELAPSED='60s', ACTION=KILL, WATCH=SIMILAR DURATION='10m0s'".
+var resourceGroupQueryLimitRE = regexp.MustCompile(`(\w+)\s*=\s*(?:'([^']*)'|([^\s,]+))`)
+
+// Metric descriptors.
+var (
+	resourceGroupRUPerSecDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_ru_per_sec"),
+		"The request units per second a resource group is filled with, +Inf when unlimited.",
+		[]string{"name"}, nil)
+	resourceGroupPriorityDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_priority"),
+		"The priority of a resource group, 1 for the current priority and 0 for the others.",
+		[]string{"name", "priority"}, nil)
+	resourceGroupBurstableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_burstable"),
+		"Whether a resource group may use more request units than it is filled with (1 for burstable, 0 for not).",
+		[]string{"name"}, nil)
+	resourceGroupQueryLimitDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_query_limit_info"),
+		"The runaway query settings of a resource group, the value is always 1.",
+		[]string{"name", "action", "watch"}, nil)
+	resourceGroupExecElapsedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_query_limit_exec_elapsed_seconds"),
+		"The execution time after which a query of a resource group is considered runaway.",
+		[]string{"name"}, nil)
+	resourceGroupUsersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "resource_group_users"),
+		"The number of users bound to a resource group.",
+		[]string{"name"}, nil)
+)
+
+// ScrapeResourceGroups collects from `information_schema.resource_groups`.
+type ScrapeResourceGroups struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeResourceGroups) Name() string {
+	return informationSchema + ".resource_groups"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeResourceGroups) Help() string {
+	return "Collect resource group settings from information_schema.resource_groups and bound users from mysql.user"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeResourceGroups) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeResourceGroups) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	users, err := userResourceGroups(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	groupRows, err := db.QueryContext(ctx, infoSchemaResourceGroupsQuery)
+	if err != nil {
+		return err
+	}
+	defer groupRows.Close()
+
+	var (
+		name       string
+		ruPerSec   string
+		priority   string
+		burstable  string
+		queryLimit string
+	)
+	for groupRows.Next() {
+		if err := groupRows.Scan(&name, &ruPerSec, &priority, &burstable, &queryLimit); err != nil {
+			return err
+		}
+
+		if strings.EqualFold(ruPerSec, "UNLIMITED") {
+			ch <- prometheus.MustNewConstMetric(resourceGroupRUPerSecDesc, prometheus.GaugeValue, math.Inf(1), name)
+		} else if value, err := strconv.ParseFloat(ruPerSec, 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(resourceGroupRUPerSecDesc, prometheus.GaugeValue, value, name)
+		}
+
+		known := false
+		for _, p := range resourceGroupPriorities {
+			value := 0.0
+			if strings.EqualFold(p, priority) {
+				value, known = 1, true
+			}
+			ch <- prometheus.MustNewConstMetric(resourceGroupPriorityDesc, prometheus.GaugeValue, value, name, p)
+		}
+		if !known {
+			ch <- prometheus.MustNewConstMetric(resourceGroupPriorityDesc, prometheus.GaugeValue, 1, name, priority)
+		}
+
+		// Newer versions report how a group bursts rather than YES or NO.
+		switch strings.ToUpper(burstable) {
+		case "YES", "MODERATED", "UNLIMITED":
+			ch <- prometheus.MustNewConstMetric(resourceGroupBurstableDesc, prometheus.GaugeValue, 1, name)
+		case "NO", "OFF":
+			ch <- prometheus.MustNewConstMetric(resourceGroupBurstableDesc, prometheus.GaugeValue, 0, name)
+		}
+
+		if queryLimit != "" {
+			settings := parseResourceGroupQueryLimit(queryLimit)
+			ch <- prometheus.MustNewConstMetric(resourceGroupQueryLimitDesc, prometheus.GaugeValue, 1,
+				name, settings["ACTION"], settings["WATCH"])
+			if elapsed, err := time.ParseDuration(settings["EXEC_ELAPSED"]); err == nil {
+				ch <- prometheus.MustNewConstMetric(resourceGroupExecElapsedDesc, prometheus.GaugeValue, elapsed.Seconds(), name)
+			}
+		}
+
+		ch <- prometheus.MustNewConstMetric(resourceGroupUsersDesc, prometheus.GaugeValue, users[strings.ToLower(name)], name)
+	}
+	return groupRows.Err()
+}
+
+// parseResourceGroupQueryLimit returns the settings of a QUERY_LIMIT by upper case name.
+func parseResourceGroupQueryLimit(queryLimit string) map[string]string {
+	settings := make(map[string]string)
+	for _, match := range resourceGroupQueryLimitRE.FindAllStringSubmatch(queryLimit, -1) {
+		settings[strings.ToUpper(match[1])] = match[2] + match[3]
+	}
+	return settings
+}
+
+// check interface
+var _ Scraper = ScrapeResourceGroups{}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeResourceGroups(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(sanitizeQuery(mysqlUserAttributesQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"user", "host", "User_attributes"}).
+			AddRow("root", "%", "").
+			AddRow("app", "%", `{"resource_group": "tenant_a"}`).
+			AddRow("report", "%", `{"resource_group": "Tenant_A", "metadata": {"team": "bi"}}`).
+			AddRow("batch", "%", `{"metadata": {}}`))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaResourceGroupsQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"NAME", "RU_PER_SEC", "PRIORITY", "BURSTABLE", "QUERY_LIMIT"}).
+			AddRow("default", "UNLIMITED", "MEDIUM", "YES", "").
+			AddRow("tenant_a", "2000", "HIGH", "NO", "EXEC_ELAPSED='60s', ACTION=KILL, WATCH=SIMILAR DURATION='10m0s'"))
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeResourceGroups{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	defaultGroup := labelMap{"name": "default"}
+	tenant := labelMap{"name": "tenant_a"}
+	expected := []MetricResult{
+		{labels: defaultGroup, value: math.Inf(1), metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "default", "priority": "LOW"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "default", "priority": "MEDIUM"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "default", "priority": "HIGH"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: defaultGroup, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: defaultGroup, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: tenant, value: 2000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "tenant_a", "priority": "LOW"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "tenant_a", "priority": "MEDIUM"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "tenant_a", "priority": "HIGH"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: tenant, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"name": "tenant_a", "action": "KILL", "watch": "SIMILAR"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: tenant, value: 60, metricType: dto.MetricType_GAUGE},
+		{labels: tenant, value: 2, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
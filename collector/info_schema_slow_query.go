@@ -0,0 +1,240 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_slow_query`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	slowQueryNowQuery = `SELECT NOW(6)`
+	// Slow queries logged at or after the cursor, oldest first.
+	infoSchemaSlowQueryQuery = `
+		  SELECT
+		    Time,
+		    INSTANCE,
+		    Conn_ID,
+		    Txn_start_ts,
+		    ifnull(User, '') as User,
+		    ifnull(DB, '') as DB,
+		    ifnull(Digest, '') as Digest,
+		    Query_time,
+		    Process_time,
+		    Wait_time,
+		    Backoff_time,
+		    Succ
+		  FROM information_schema.cluster_slow_query
+		  WHERE Time >= '%s'
+		  ORDER BY Time
+		  LIMIT %d
+		`
+)
+
+// Tunable flags.
+var (
+	slowQueryLimit = kingpin.Flag(
+		"collect.info_schema.slow_query.limit",
+		"Maximum number of slow queries to read per scrape, the rest is read by the following scrapes",
+	).Default("1000").Int()
+	slowQueryDigestLimit = kingpin.Flag(
+		"collect.info_schema.slow_query.digest_limit",
+		"Maximum number of digests to count slow queries for, the most frequent ones recently, slow queries of further digests are counted with an empty digest",
+	).Default("100").Int()
+	slowQueryDigestWindow = kingpin.Flag(
+		"collect.info_schema.slow_query.digest_window",
+		"Window in seconds over which digests are ranked for the digest limit, digests are ranked by their slow queries in the current and the previous window",
+	).Default("3600").Int()
+)
+
+// Buckets of slow query time histograms, in seconds.
+var slowQueryBuckets = []float64{0.01, 0.05, 0.1, 0.3, 0.5, 1, 2, 5, 10, 30, 60, 300}
+
+// slowQueryState is kept between scrapes of a target.
+type slowQueryState struct {
+	mu sync.Mutex
+	// Time of the last slow query counted, and the slow queries counted at that time.
+	cursor string
+	seen   map[string]bool
+	// Digests which are counted separately.
+	digests *topDigests
+
+	queryTime     *prometheus.HistogramVec
+	processTime   *prometheus.HistogramVec
+	waitTime      *prometheus.HistogramVec
+	backoffTime   *prometheus.HistogramVec
+	queries       *prometheus.CounterVec
+	digestQueries *prometheus.CounterVec
+}
+
+func newSlowQueryHistogramVec(name, help string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: informationSchema,
+		Name:      name,
+		Help:      help,
+		Buckets:   slowQueryBuckets,
+	}, []string{"instance"})
+}
+
+func newSlowQueryState() interface{} {
+	return &slowQueryState{
+		seen:        make(map[string]bool),
+		digests:     newTopDigests(),
+		queryTime:   newSlowQueryHistogramVec("slow_query_duration_seconds", "The query time of slow queries."),
+		processTime: newSlowQueryHistogramVec("slow_query_process_time_seconds", "The coprocessor process time of slow queries."),
+		waitTime:    newSlowQueryHistogramVec("slow_query_wait_time_seconds", "The coprocessor wait time of slow queries."),
+		backoffTime: newSlowQueryHistogramVec("slow_query_backoff_time_seconds", "The backoff time of slow queries."),
+		queries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "slow_queries_total",
+			Help:      "The number of slow queries by instance, database, user and success.",
+		}, []string{"instance", "db", "user", "succ"}),
+		digestQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: informationSchema,
+			Name:      "slow_queries_by_digest_total",
+			Help:      "The number of slow queries by digest.",
+		}, []string{"digest"}),
+	}
+}
+
+// ScrapeSlowQuery collects from `information_schema.cluster_slow_query`.
+type ScrapeSlowQuery struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeSlowQuery) Name() string {
+	return informationSchema + ".slow_query"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeSlowQuery) Help() string {
+	return "Collect slow query histograms and counters incrementally from information_schema.cluster_slow_query"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeSlowQuery) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+// Without state kept between scrapes, no slow queries are counted.
+func (s ScrapeSlowQuery) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Each slow query logged after the first scrape of the target is counted once.
+func (s ScrapeSlowQuery) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	state := targetState.Load(s.Name(), newSlowQueryState).(*slowQueryState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.cursor == "" {
+		// Slow queries logged before the first scrape are not counted.
+		if err := db.QueryRowContext(ctx, slowQueryNowQuery).Scan(&state.cursor); err != nil {
+			return err
+		}
+	} else if err := s.readSlowQueries(ctx, db, state, logger); err != nil {
+		return err
+	}
+
+	state.queryTime.Collect(ch)
+	state.processTime.Collect(ch)
+	state.waitTime.Collect(ch)
+	state.backoffTime.Collect(ch)
+	state.queries.Collect(ch)
+	state.digestQueries.Collect(ch)
+	return nil
+}
+
+func (ScrapeSlowQuery) readSlowQueries(ctx context.Context, db *sql.DB, state *slowQueryState, logger log.Logger) error {
+	slowQueryRows, err := db.QueryContext(ctx, fmt.Sprintf(infoSchemaSlowQueryQuery, state.cursor, *slowQueryLimit))
+	if err != nil {
+		return err
+	}
+	defer slowQueryRows.Close()
+
+	var (
+		logTime     string
+		instance    string
+		connID      string
+		txnStartTS  string
+		user        string
+		database    string
+		digest      string
+		queryTime   float64
+		processTime float64
+		waitTime    float64
+		backoffTime float64
+		succ        bool
+		read        int
+	)
+	for slowQueryRows.Next() {
+		if err := slowQueryRows.Scan(
+			&logTime, &instance, &connID, &txnStartTS, &user, &database, &digest,
+			&queryTime, &processTime, &waitTime, &backoffTime, &succ,
+		); err != nil {
+			return err
+		}
+		read++
+
+		id := strings.Join([]string{instance, connID, txnStartTS, digest}, "/")
+		if logTime == state.cursor && state.seen[id] {
+			continue
+		}
+		if logTime != state.cursor {
+			state.cursor = logTime
+			state.seen = make(map[string]bool)
+		}
+		state.seen[id] = true
+
+		state.queryTime.WithLabelValues(instance).Observe(queryTime)
+		state.processTime.WithLabelValues(instance).Observe(processTime)
+		state.waitTime.WithLabelValues(instance).Observe(waitTime)
+		state.backoffTime.WithLabelValues(instance).Observe(backoffTime)
+		state.queries.WithLabelValues(instance, database, user, strconv.FormatBool(succ)).Inc()
+
+		state.digestQueries.WithLabelValues(state.digests.observe(digest, *slowQueryDigestLimit)).Inc()
+	}
+	if err := slowQueryRows.Err(); err != nil {
+		return err
+	}
+
+	// Digests which are no longer among the most frequent are counted with an empty digest from now on.
+	window := time.Duration(*slowQueryDigestWindow) * time.Second
+	for _, digest := range state.digests.update(time.Now(), window, *slowQueryDigestLimit) {
+		state.digestQueries.DeleteLabelValues(digest)
+	}
+	if read == *slowQueryLimit {
+		level.Debug(logger).Log("msg", "Slow query limit reached, the rest is read by the following scrapes", "limit", *slowQueryLimit)
+	}
+	return nil
+}
+
+// check interface
+var _ StatefulScraper = ScrapeSlowQuery{}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeSlowQuery(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.slow_query.limit=100",
+		"--collect.info_schema.slow_query.digest_limit=1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"Time", "INSTANCE", "Conn_ID", "Txn_start_ts", "User", "DB", "Digest",
+		"Query_time", "Process_time", "Wait_time", "Backoff_time", "Succ"}
+	mock.ExpectQuery(sanitizeQuery(slowQueryNowQuery)).WillReturnRows(sqlmock.NewRows([]string{"NOW(6)"}).
+		AddRow("2026-10-16 10:00:00.000000"))
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaSlowQueryQuery, "2026-10-16 10:00:00.000000", 100))).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("2026-10-16 10:00:01.000001", "tidb-0:10080", "1", "446150000000000001", "app", "shop", "aaa", 0.5, 0.2, 0.05, 0, 1).
+			AddRow("2026-10-16 10:00:02.000000", "tidb-0:10080", "2", "446150000000000002", "app", "shop", "bbb", 2, 1.5, 0.1, 0.2, 0))
+	// The last slow query of the previous scrape is returned again and not counted twice.
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaSlowQueryQuery, "2026-10-16 10:00:02.000000", 100))).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("2026-10-16 10:00:02.000000", "tidb-0:10080", "2", "446150000000000002", "app", "shop", "bbb", 2, 1.5, 0.1, 0.2, 0).
+			AddRow("2026-10-16 10:00:02.000000", "tidb-0:10080", "3", "446150000000000003", "app", "shop", "aaa", 0.4, 0.1, 0, 0, 1))
+	// A digest becoming more frequent than the tracked one replaces it after the scrape.
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaSlowQueryQuery, "2026-10-16 10:00:02.000000", 100))).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("2026-10-16 10:00:03.000000", "tidb-0:10080", "4", "446150000000000004", "app", "shop", "bbb", 0.4, 0.1, 0, 0, 1).
+			AddRow("2026-10-16 10:00:03.000000", "tidb-0:10080", "5", "446150000000000005", "app", "shop", "bbb", 0.4, 0.1, 0, 0, 1).
+			AddRow("2026-10-16 10:00:03.000000", "tidb-0:10080", "6", "446150000000000006", "app", "shop", "bbb", 0.4, 0.1, 0, 0, 1))
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaSlowQueryQuery, "2026-10-16 10:00:03.000000", 100))).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("2026-10-16 10:00:04.000000", "tidb-0:10080", "7", "446150000000000007", "app", "shop", "bbb", 0.4, 0.1, 0, 0, 1))
+
+	state := NewState().Target("")
+	for i, expected := range [][]MetricResult{
+		{},
+		{
+			{labels: labelMap{"instance": "tidb-0:10080"}, value: 2, metricType: dto.MetricType_HISTOGRAM},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "true"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "false"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": "aaa"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": ""}, value: 1, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "tidb-0:10080"}, value: 3, metricType: dto.MetricType_HISTOGRAM},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "true"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "false"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": "aaa"}, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": ""}, value: 1, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "tidb-0:10080"}, value: 6, metricType: dto.MetricType_HISTOGRAM},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "true"}, value: 5, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "false"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": ""}, value: 4, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labelMap{"instance": "tidb-0:10080"}, value: 7, metricType: dto.MetricType_HISTOGRAM},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "true"}, value: 6, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"instance": "tidb-0:10080", "db": "shop", "user": "app", "succ": "false"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": "bbb"}, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labelMap{"digest": ""}, value: 4, metricType: dto.MetricType_COUNTER},
+		},
+	} {
+		ch := make(chan prometheus.Metric)
+		go func() {
+			if err := (ScrapeSlowQuery{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+				t.Errorf("error calling function on test: %s", err)
+			}
+			close(ch)
+		}()
+
+		var got []MetricResult
+		for m := range ch {
+			got = append(got, readMetric(m))
+		}
+		convey.Convey(fmt.Sprintf("Metrics comparison of scrape %d", i+1), t, func() {
+			// Four histograms of the instance and the counters, in no particular order.
+			if len(expected) > 0 {
+				convey.So(got, convey.ShouldHaveLength, len(expected)+3)
+			} else {
+				convey.So(got, convey.ShouldBeEmpty)
+			}
+			for _, expect := range expected {
+				convey.So(got, convey.ShouldContain, expect)
+			}
+		})
+	}
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
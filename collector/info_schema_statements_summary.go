@@ -0,0 +1,415 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_statements_summary`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// TiDB splits a digest into several rows of a window, such as COMMIT by the
+// previous statement or by resource group, they are aggregated into one.
+// The rows of statements evicted from the summary have a NULL digest.
+const statementsSummaryColumns = `
+	    SUMMARY_BEGIN_TIME,
+	    SUMMARY_END_TIME,
+	    INSTANCE,
+	    ifnull(SCHEMA_NAME, '') as SCHEMA_NAME,
+	    ifnull(DIGEST, '') as DIGEST,
+	    ifnull(PLAN_DIGEST, '') as PLAN_DIGEST,
+	    ifnull(MAX(STMT_TYPE), '') as STMT_TYPE,
+	    LEFT(ifnull(MAX(DIGEST_TEXT), ''), %d) as DIGEST_TEXT,
+	    SUM(EXEC_COUNT) as EXEC_COUNT,
+	    SUM(SUM_LATENCY) as SUM_LATENCY,
+	    MAX(MAX_LATENCY) as MAX_LATENCY,
+	    SUM(AVG_PROCESS_TIME * EXEC_COUNT) as SUM_PROCESS_TIME,
+	    SUM(AVG_WAIT_TIME * EXEC_COUNT) as SUM_WAIT_TIME,
+	    SUM(AVG_BACKOFF_TIME * EXEC_COUNT) as SUM_BACKOFF_TIME,
+	    SUM(SUM_COP_TASK_NUM) as SUM_COP_TASK_NUM,
+	    SUM(AVG_AFFECTED_ROWS * EXEC_COUNT) as SUM_AFFECTED_ROWS,
+	    SUM(AVG_RESULT_ROWS * EXEC_COUNT) as SUM_RESULT_ROWS,
+	    MAX(MAX_MEM) as MAX_MEM,
+	    MAX(MAX_DISK) as MAX_DISK
+	`
+
+const statementsSummaryGroupBy = `
+	  GROUP BY SUMMARY_BEGIN_TIME, SUMMARY_END_TIME, INSTANCE, SCHEMA_NAME, DIGEST, PLAN_DIGEST
+	`
+
+const infoSchemaStatementsSummaryQuery = `
+	SELECT` + statementsSummaryColumns + `
+	  FROM information_schema.cluster_statements_summary
+	  WHERE LAST_SEEN > DATE_SUB(NOW(), INTERVAL %d SECOND)` + statementsSummaryGroupBy + `
+	  ORDER BY SUM(SUM_LATENCY) DESC
+	  LIMIT %d
+	`
+
+// Windows that were closed since the previous scrape, with their final values.
+const infoSchemaStatementsSummaryHistoryQuery = `
+	SELECT` + statementsSummaryColumns + `
+	  FROM information_schema.cluster_statements_summary_history
+	  WHERE SUMMARY_BEGIN_TIME >= '%s'` + statementsSummaryGroupBy + `
+	  ORDER BY SUMMARY_BEGIN_TIME
+	`
+
+// Tunable flags.
+var (
+	statementsSummaryLimit = kingpin.Flag(
+		"collect.info_schema.statements_summary.limit",
+		"Limit the number of statements summary digests by response time",
+	).Default("250").Int()
+	statementsSummaryTimeLimit = kingpin.Flag(
+		"collect.info_schema.statements_summary.timelimit",
+		"Limit how old the 'last_seen' statements summary digests can be, in seconds",
+	).Default("86400").Int()
+	statementsSummaryDigestTextLimit = kingpin.Flag(
+		"collect.info_schema.statements_summary.digest_text_limit",
+		"Maximum length of the normalized statement text",
+	).Default("120").Int()
+	statementsSummaryExpiry = kingpin.Flag(
+		"collect.info_schema.statements_summary.expiry",
+		"Stop exporting counters of digests which have not been seen for this many seconds",
+	).Default("3600").Int()
+)
+
+var statementsSummaryLabels = []string{"instance", "schema", "digest", "plan_digest", "stmt_type", "digest_text"}
+
+// Metric descriptors.
+var (
+	statementsSummaryExecutionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_executions_total"),
+		"The total number of executions by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_latency_seconds_total"),
+		"The total latency by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryProcessTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_process_time_seconds_total"),
+		"The total coprocessor process time by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryWaitTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_wait_time_seconds_total"),
+		"The total coprocessor wait time by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryBackoffTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_backoff_time_seconds_total"),
+		"The total backoff time by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryCopTasksDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_cop_tasks_total"),
+		"The total number of coprocessor tasks by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryAffectedRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_affected_rows_total"),
+		"The total number of affected rows by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryResultRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_result_rows_total"),
+		"The total number of returned rows by digest.",
+		statementsSummaryLabels, nil)
+	statementsSummaryAvgLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_avg_latency_seconds"),
+		"The average latency by digest in the current summary window.",
+		statementsSummaryLabels, nil)
+	statementsSummaryMaxLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_max_latency_seconds"),
+		"The maximum latency by digest in the current summary window.",
+		statementsSummaryLabels, nil)
+	statementsSummaryMaxMemDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_max_memory_bytes"),
+		"The maximum memory used by digest in the current summary window.",
+		statementsSummaryLabels, nil)
+	statementsSummaryMaxDiskDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "statements_summary_max_disk_bytes"),
+		"The maximum disk space used by digest in the current summary window.",
+		statementsSummaryLabels, nil)
+)
+
+// statementsSummaryValues are the values of a digest which add up over summary windows.
+// Times are in seconds.
+type statementsSummaryValues struct {
+	execCount, latency, processTime, waitTime, backoffTime float64
+	copTasks, affectedRows, resultRows                     float64
+}
+
+func (v *statementsSummaryValues) add(o statementsSummaryValues) {
+	v.execCount += o.execCount
+	v.latency += o.latency
+	v.processTime += o.processTime
+	v.waitTime += o.waitTime
+	v.backoffTime += o.backoffTime
+	v.copTasks += o.copTasks
+	v.affectedRows += o.affectedRows
+	v.resultRows += o.resultRows
+}
+
+// statementsSummaryRow is one digest of one instance in one statements summary window.
+type statementsSummaryRow struct {
+	beginTime, endTime                                         string
+	instance, schema, digest, planDigest, stmtType, digestText string
+
+	statementsSummaryValues
+	avgLatency, maxLatency, maxMem, maxDisk float64
+}
+
+// merge adds the values of another row of the same window and key.
+func (r *statementsSummaryRow) merge(o statementsSummaryRow) {
+	r.add(o.statementsSummaryValues)
+	r.maxLatency = math.Max(r.maxLatency, o.maxLatency)
+	r.maxMem = math.Max(r.maxMem, o.maxMem)
+	r.maxDisk = math.Max(r.maxDisk, o.maxDisk)
+}
+
+func (r statementsSummaryRow) key() string {
+	return strings.Join([]string{r.instance, r.schema, r.digest, r.planDigest, r.stmtType}, "\x00")
+}
+
+func (r statementsSummaryRow) labels() []string {
+	return []string{r.instance, r.schema, r.digest, r.planDigest, r.stmtType, r.digestText}
+}
+
+// statementsSummaryDigest stitches the summary windows of one digest into counters.
+// Window times are compared as strings, which sort chronologically in the TIMESTAMP format.
+type statementsSummaryDigest struct {
+	labels []string
+	// Totals of closed windows.
+	folded statementsSummaryValues
+	// Windows beginning before this time are folded or were never counted.
+	foldedUntil string
+	// Last values of windows which are not folded yet, by begin time.
+	windows  map[string]statementsSummaryValues
+	lastSeen time.Time
+}
+
+func newStatementsSummaryDigest(r statementsSummaryRow) *statementsSummaryDigest {
+	return &statementsSummaryDigest{
+		labels:      r.labels(),
+		foldedUntil: r.beginTime,
+		windows:     make(map[string]statementsSummaryValues),
+	}
+}
+
+// observe records the values of a window. Final values come from the history table.
+func (d *statementsSummaryDigest) observe(r statementsSummaryRow, final bool) {
+	if r.beginTime < d.foldedUntil {
+		return
+	}
+	if last, ok := d.windows[r.beginTime]; ok && r.execCount < last.execCount {
+		// The digest was evicted from the window and came back, keep what was counted before.
+		d.folded.add(last)
+	}
+	d.windows[r.beginTime] = r.statementsSummaryValues
+
+	// Older windows missing from history are folded with the last values seen.
+	foldBefore := r.beginTime
+	if final {
+		foldBefore = r.endTime
+	}
+	for begin, values := range d.windows {
+		if begin < foldBefore {
+			d.folded.add(values)
+			delete(d.windows, begin)
+		}
+	}
+	if foldBefore > d.foldedUntil {
+		d.foldedUntil = foldBefore
+	}
+}
+
+func (d *statementsSummaryDigest) total() statementsSummaryValues {
+	total := d.folded
+	for _, values := range d.windows {
+		total.add(values)
+	}
+	return total
+}
+
+// statementsSummaryState is kept between scrapes of a target.
+type statementsSummaryState struct {
+	mu sync.Mutex
+	// The earliest begin time of the current windows at the previous scrape.
+	lastBegin string
+	digests   map[string]*statementsSummaryDigest
+}
+
+func newStatementsSummaryState() interface{} {
+	return &statementsSummaryState{
+		digests: make(map[string]*statementsSummaryDigest),
+	}
+}
+
+// ScrapeStatementsSummary collects from `information_schema.cluster_statements_summary`.
+type ScrapeStatementsSummary struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeStatementsSummary) Name() string {
+	return informationSchema + ".statements_summary"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeStatementsSummary) Help() string {
+	return "Collect metrics from information_schema.cluster_statements_summary"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeStatementsSummary) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+// Without state kept between scrapes, counters only cover the current summary window.
+func (s ScrapeStatementsSummary) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// Summary windows are stitched together with the history table into counters.
+func (s ScrapeStatementsSummary) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	state := targetState.Load(s.Name(), newStatementsSummaryState).(*statementsSummaryState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	current, err := queryStatementsSummary(ctx, db, fmt.Sprintf(
+		infoSchemaStatementsSummaryQuery,
+		*statementsSummaryDigestTextLimit,
+		*statementsSummaryTimeLimit,
+		*statementsSummaryLimit,
+	))
+	if err != nil {
+		return err
+	}
+	var history []statementsSummaryRow
+	if state.lastBegin != "" {
+		history, err = queryStatementsSummary(ctx, db, fmt.Sprintf(
+			infoSchemaStatementsSummaryHistoryQuery,
+			*statementsSummaryDigestTextLimit,
+			state.lastBegin,
+		))
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	for _, r := range current {
+		if _, ok := state.digests[r.key()]; !ok {
+			state.digests[r.key()] = newStatementsSummaryDigest(r)
+		}
+	}
+	// Only digests which are already tracked are followed into the history table.
+	for _, r := range history {
+		if d, ok := state.digests[r.key()]; ok {
+			d.observe(r, true)
+			d.lastSeen = now
+		}
+	}
+	lastBegin := ""
+	for _, r := range current {
+		d := state.digests[r.key()]
+		d.observe(r, false)
+		d.labels = r.labels()
+		d.lastSeen = now
+		if lastBegin == "" || r.beginTime < lastBegin {
+			lastBegin = r.beginTime
+		}
+
+		labels := r.labels()
+		ch <- prometheus.MustNewConstMetric(statementsSummaryAvgLatencyDesc, prometheus.GaugeValue, r.avgLatency, labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryMaxLatencyDesc, prometheus.GaugeValue, r.maxLatency, labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryMaxMemDesc, prometheus.GaugeValue, r.maxMem, labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryMaxDiskDesc, prometheus.GaugeValue, r.maxDisk, labels...)
+	}
+	if lastBegin != "" {
+		state.lastBegin = lastBegin
+	}
+
+	expiry := time.Duration(*statementsSummaryExpiry) * time.Second
+	for _, key := range sortedMapKeys(state.digests) {
+		d := state.digests[key]
+		if now.Sub(d.lastSeen) > expiry {
+			delete(state.digests, key)
+			continue
+		}
+		total := d.total()
+		ch <- prometheus.MustNewConstMetric(statementsSummaryExecutionsDesc, prometheus.CounterValue, total.execCount, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryLatencyDesc, prometheus.CounterValue, total.latency, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryProcessTimeDesc, prometheus.CounterValue, total.processTime, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryWaitTimeDesc, prometheus.CounterValue, total.waitTime, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryBackoffTimeDesc, prometheus.CounterValue, total.backoffTime, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryCopTasksDesc, prometheus.CounterValue, total.copTasks, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryAffectedRowsDesc, prometheus.CounterValue, total.affectedRows, d.labels...)
+		ch <- prometheus.MustNewConstMetric(statementsSummaryResultRowsDesc, prometheus.CounterValue, total.resultRows, d.labels...)
+	}
+
+	return nil
+}
+
+func queryStatementsSummary(ctx context.Context, db *sql.DB, query string) ([]statementsSummaryRow, error) {
+	// Timers here are returned in nanoseconds.
+	statementsSummaryRows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer statementsSummaryRows.Close()
+
+	var result []statementsSummaryRow
+	// Index of the row of each window and key, rows which are still split are merged.
+	index := make(map[string]int)
+	for statementsSummaryRows.Next() {
+		var r statementsSummaryRow
+		if err := statementsSummaryRows.Scan(
+			&r.beginTime, &r.endTime,
+			&r.instance, &r.schema, &r.digest, &r.planDigest, &r.stmtType, &r.digestText,
+			&r.execCount, &r.latency, &r.maxLatency,
+			&r.processTime, &r.waitTime, &r.backoffTime,
+			&r.copTasks, &r.affectedRows, &r.resultRows, &r.maxMem, &r.maxDisk,
+		); err != nil {
+			return nil, err
+		}
+		r.latency /= nanoSeconds
+		r.maxLatency /= nanoSeconds
+		r.processTime /= nanoSeconds
+		r.waitTime /= nanoSeconds
+		r.backoffTime /= nanoSeconds
+
+		id := r.beginTime + "\x00" + r.key()
+		if i, ok := index[id]; ok {
+			result[i].merge(r)
+			continue
+		}
+		index[id] = len(result)
+		result = append(result, r)
+	}
+	for i := range result {
+		if result[i].execCount > 0 {
+			result[i].avgLatency = result[i].latency / result[i].execCount
+		}
+	}
+	return result, statementsSummaryRows.Err()
+}
+
+// check interface
+var _ StatefulScraper = ScrapeStatementsSummary{}
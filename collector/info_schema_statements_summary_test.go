@@ -0,0 +1,259 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var statementsSummaryTestColumns = []string{"SUMMARY_BEGIN_TIME", "SUMMARY_END_TIME", "INSTANCE", "SCHEMA_NAME",
+	"DIGEST", "PLAN_DIGEST", "STMT_TYPE", "DIGEST_TEXT", "EXEC_COUNT", "SUM_LATENCY", "MAX_LATENCY",
+	"SUM_PROCESS_TIME", "SUM_WAIT_TIME", "SUM_BACKOFF_TIME", "SUM_COP_TASK_NUM", "SUM_AFFECTED_ROWS",
+	"SUM_RESULT_ROWS", "MAX_MEM", "MAX_DISK"}
+
+func TestScrapeStatementsSummary(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.statements_summary.limit=10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf(infoSchemaStatementsSummaryQuery, 120, 86400, 10)
+	historyQuery := fmt.Sprintf(infoSchemaStatementsSummaryHistoryQuery, 120, "2026-10-16 10:00:00")
+	digestText := "select * from `orders` where `id` = ?"
+
+	// First scrape: only the current window is known.
+	mock.ExpectQuery(sanitizeQuery(query)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "3d1f", "9a2b", "Select", digestText,
+			4, 8000000000, 5000000000, 2000000000, 1000000000, 0, 12, 0, 14, 1048576, 0))
+	// Second scrape: the window was moved to history with more executions, and a new window began.
+	mock.ExpectQuery(sanitizeQuery(query)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns).
+		AddRow("2026-10-16 10:30:00", "2026-10-16 11:00:00", "tidb-0:10080", "shop", "3d1f", "9a2b", "Select", digestText,
+			1, 1000000000, 1000000000, 0, 0, 0, 1, 0, 1, 4096, 0))
+	mock.ExpectQuery(sanitizeQuery(historyQuery)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "3d1f", "9a2b", "Select", digestText,
+			6, 10000000000, 5000000000, 3000000000, 1500000000, 0, 20, 0, 18, 1048576, 0).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "77aa", "", "Insert", "insert into `t` values ( ... )",
+			100, 1000000000, 20000000, 0, 0, 0, 0, 100, 0, 0, 0))
+
+	labels := labelMap{"instance": "tidb-0:10080", "schema": "shop", "digest": "3d1f", "plan_digest": "9a2b", "stmt_type": "Select", "digest_text": digestText}
+	state := NewState().Target("")
+	for i, expected := range [][]MetricResult{
+		{
+			{labels: labels, value: 2, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 5, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 1048576, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 0, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 4, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 8, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 2, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 1, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 12, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 14, metricType: dto.MetricType_COUNTER},
+		},
+		{
+			{labels: labels, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 1, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 4096, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 0, metricType: dto.MetricType_GAUGE},
+			{labels: labels, value: 7, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 11, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 3, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 1.5, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 21, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 0, metricType: dto.MetricType_COUNTER},
+			{labels: labels, value: 19, metricType: dto.MetricType_COUNTER},
+		},
+	} {
+		ch := make(chan prometheus.Metric)
+		go func() {
+			if err := (ScrapeStatementsSummary{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+				t.Errorf("error calling function on test: %s", err)
+			}
+			close(ch)
+		}()
+
+		convey.Convey(fmt.Sprintf("Metrics comparison of scrape %d", i+1), t, func() {
+			for _, expect := range expected {
+				got := readMetric(<-ch)
+				convey.So(expect, convey.ShouldResemble, got)
+			}
+			_, ok := <-ch
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+	}
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
+
+func TestScrapeStatementsSummarySplitRows(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.statements_summary.limit=10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	// Two COMMIT rows of the same window and key, as split by the previous statement, and the row of evicted statements.
+	query := fmt.Sprintf(infoSchemaStatementsSummaryQuery, 120, 86400, 10)
+	mock.ExpectQuery(sanitizeQuery(query)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "c0ff", "", "Commit", "commit",
+			3, 3000000000, 2000000000, 0, 0, 0, 0, 0, 0, 1024, 0).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "c0ff", "", "Commit", "commit",
+			2, 1000000000, 500000000, 0, 0, 0, 0, 0, 0, 2048, 0).
+		AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "", "", "", "", "",
+			10, 5000000000, 1000000000, 0, 0, 0, 0, 0, 0, 0, 0))
+
+	commit := labelMap{"instance": "tidb-0:10080", "schema": "shop", "digest": "c0ff", "plan_digest": "", "stmt_type": "Commit", "digest_text": "commit"}
+	other := labelMap{"instance": "tidb-0:10080", "schema": "", "digest": "", "plan_digest": "", "stmt_type": "", "digest_text": ""}
+	expected := []MetricResult{
+		{labels: commit, value: 0.8, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 2048, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: other, value: 0.5, metricType: dto.MetricType_GAUGE},
+		{labels: other, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: other, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: other, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: other, value: 10, metricType: dto.MetricType_COUNTER},
+		{labels: other, value: 5, metricType: dto.MetricType_COUNTER},
+	}
+	for i := 0; i < 6; i++ {
+		expected = append(expected, MetricResult{labels: other, value: 0, metricType: dto.MetricType_COUNTER})
+	}
+	expected = append(expected,
+		MetricResult{labels: commit, value: 5, metricType: dto.MetricType_COUNTER},
+		MetricResult{labels: commit, value: 4, metricType: dto.MetricType_COUNTER},
+	)
+	for i := 0; i < 6; i++ {
+		expected = append(expected, MetricResult{labels: commit, value: 0, metricType: dto.MetricType_COUNTER})
+	}
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err := (ScrapeStatementsSummary{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
+
+func TestScrapeStatementsSummarySplitRowsCounters(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.statements_summary.limit=10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	// The split rows are returned unchanged by every scrape, the counters must not grow.
+	query := fmt.Sprintf(infoSchemaStatementsSummaryQuery, 120, 86400, 10)
+	historyQuery := fmt.Sprintf(infoSchemaStatementsSummaryHistoryQuery, 120, "2026-10-16 10:00:00")
+	for i := 0; i < 3; i++ {
+		mock.ExpectQuery(sanitizeQuery(query)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns).
+			AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "c0ff", "", "Commit", "commit",
+				5, 5000000000, 2000000000, 0, 0, 0, 0, 0, 0, 0, 0).
+			AddRow("2026-10-16 10:00:00", "2026-10-16 10:30:00", "tidb-0:10080", "shop", "c0ff", "", "Commit", "commit",
+				3, 1000000000, 1000000000, 0, 0, 0, 0, 0, 0, 0, 0))
+		if i > 0 {
+			mock.ExpectQuery(sanitizeQuery(historyQuery)).WillReturnRows(sqlmock.NewRows(statementsSummaryTestColumns))
+		}
+	}
+
+	commit := labelMap{"instance": "tidb-0:10080", "schema": "shop", "digest": "c0ff", "plan_digest": "", "stmt_type": "Commit", "digest_text": "commit"}
+	expected := []MetricResult{
+		{labels: commit, value: 0.75, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: commit, value: 8, metricType: dto.MetricType_COUNTER},
+		{labels: commit, value: 6, metricType: dto.MetricType_COUNTER},
+	}
+	for i := 0; i < 6; i++ {
+		expected = append(expected, MetricResult{labels: commit, value: 0, metricType: dto.MetricType_COUNTER})
+	}
+
+	state := NewState().Target("")
+	for i := 0; i < 3; i++ {
+		ch := make(chan prometheus.Metric)
+		go func() {
+			if err := (ScrapeStatementsSummary{}).ScrapeWithState(context.Background(), db, ch, state, log.NewNopLogger()); err != nil {
+				t.Errorf("error calling function on test: %s", err)
+			}
+			close(ch)
+		}()
+
+		convey.Convey(fmt.Sprintf("Metrics comparison of scrape %d", i+1), t, func() {
+			for _, expect := range expected {
+				got := readMetric(<-ch)
+				convey.So(expect, convey.ShouldResemble, got)
+			}
+			_, ok := <-ch
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+	}
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
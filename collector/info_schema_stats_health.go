@@ -0,0 +1,242 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `SHOW STATS_HEALTHY`, `mysql.stats_meta` and `information_schema.analyze_status`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	showStatsHealthyQuery = `SHOW STATS_HEALTHY`
+	// The stats of a partitioned table are kept for the table and for each partition.
+	statsMetaQuery = `
+		  SELECT
+		    tables.TABLE_SCHEMA,
+		    tables.TABLE_NAME,
+		    '' as PARTITION_NAME,
+		    meta.version,
+		    meta.modify_count,
+		    meta.count
+		  FROM mysql.stats_meta meta
+		  JOIN information_schema.tables tables ON tables.TIDB_TABLE_ID = meta.table_id
+		  UNION ALL
+		  SELECT
+		    partitions.TABLE_SCHEMA,
+		    partitions.TABLE_NAME,
+		    partitions.PARTITION_NAME,
+		    meta.version,
+		    meta.modify_count,
+		    meta.count
+		  FROM mysql.stats_meta meta
+		  JOIN information_schema.partitions partitions ON partitions.TIDB_PARTITION_ID = meta.table_id
+		`
+	infoSchemaAnalyzeStatusQuery = `
+		  SELECT
+		    TABLE_SCHEMA,
+		    TABLE_NAME,
+		    STATE,
+		    COUNT(*) as JOBS,
+		    ifnull(TIMESTAMPDIFF(SECOND, MAX(END_TIME), NOW()), 0) as LAST_END_AGE
+		  FROM information_schema.analyze_status
+		  GROUP BY TABLE_SCHEMA, TABLE_NAME, STATE
+		`
+)
+
+// Tunable flags.
+var (
+	statsHealthDatabases = kingpin.Flag(
+		"collect.info_schema.stats_health.databases",
+		"The list of databases to collect stats health for, or '*' for all",
+	).Default("*").String()
+)
+
+// Databases left out when collecting all databases, as for information_schema.tables.
+var statsHealthSystemDatabases = map[string]bool{
+	"mysql":              true,
+	"performance_schema": true,
+	"information_schema": true,
+}
+
+// States of analyze jobs which are always exported.
+var analyzeJobStates = []string{"pending", "running", "failed"}
+
+// Metric descriptors.
+var (
+	statsHealthyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "stats_healthy"),
+		"The healthy score of the statistics of a table or partition, between 0 and 100.",
+		[]string{"schema", "table", "partition"}, nil)
+	statsModifyRatioDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "stats_modify_ratio"),
+		"The number of rows modified since the statistics were collected, relative to the row count of a table or partition.",
+		[]string{"schema", "table", "partition"}, nil)
+	statsUpdateTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "stats_update_timestamp_seconds"),
+		"The time the statistics meta of a table or partition was last updated.",
+		[]string{"schema", "table", "partition"}, nil)
+	analyzeJobsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "analyze_jobs"),
+		"The number of analyze jobs in information_schema.analyze_status by state.",
+		[]string{"state"}, nil)
+	analyzeLastSuccessAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "analyze_last_success_age_seconds"),
+		"The time since the last successful analyze of a table ended.",
+		[]string{"schema", "table"}, nil)
+)
+
+// ScrapeStatsHealth collects from `SHOW STATS_HEALTHY`, `mysql.stats_meta` and `information_schema.analyze_status`.
+type ScrapeStatsHealth struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeStatsHealth) Name() string {
+	return informationSchema + ".stats_health"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeStatsHealth) Help() string {
+	return "Collect statistics health per table and analyze job status from SHOW STATS_HEALTHY, mysql.stats_meta and information_schema.analyze_status"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeStatsHealth) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeStatsHealth) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	filter := newListFilter(*statsHealthDatabases)
+	collected := func(database string) bool {
+		if filter == nil {
+			return !statsHealthSystemDatabases[strings.ToLower(database)]
+		}
+		return filter.matches(database)
+	}
+
+	if err := scrapeStatsHealthy(ctx, db, ch, collected); err != nil {
+		return err
+	}
+	if err := scrapeStatsMeta(ctx, db, ch, collected); err != nil {
+		return err
+	}
+	return scrapeAnalyzeStatus(ctx, db, ch, collected)
+}
+
+func scrapeStatsHealthy(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, collected func(string) bool) error {
+	healthyRows, err := db.QueryContext(ctx, showStatsHealthyQuery)
+	if err != nil {
+		return err
+	}
+	defer healthyRows.Close()
+
+	var (
+		database  string
+		table     string
+		partition string
+		healthy   float64
+	)
+	for healthyRows.Next() {
+		if err := healthyRows.Scan(&database, &table, &partition, &healthy); err != nil {
+			return err
+		}
+		if !collected(database) {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(statsHealthyDesc, prometheus.GaugeValue, healthy, database, table, partition)
+	}
+	return healthyRows.Err()
+}
+
+func scrapeStatsMeta(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, collected func(string) bool) error {
+	metaRows, err := db.QueryContext(ctx, statsMetaQuery)
+	if err != nil {
+		return err
+	}
+	defer metaRows.Close()
+
+	var (
+		database    string
+		table       string
+		partition   string
+		version     uint64
+		modifyCount float64
+		count       float64
+	)
+	for metaRows.Next() {
+		if err := metaRows.Scan(&database, &table, &partition, &version, &modifyCount, &count); err != nil {
+			return err
+		}
+		if !collected(database) {
+			continue
+		}
+		var modifyRatio float64
+		if count > 0 {
+			modifyRatio = modifyCount / count
+		}
+		ch <- prometheus.MustNewConstMetric(statsModifyRatioDesc, prometheus.GaugeValue, modifyRatio, database, table, partition)
+		// The version is a TSO, its physical part is in milliseconds.
+		ch <- prometheus.MustNewConstMetric(statsUpdateTimeDesc, prometheus.GaugeValue, float64(version>>18)/1e3, database, table, partition)
+	}
+	return metaRows.Err()
+}
+
+func scrapeAnalyzeStatus(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, collected func(string) bool) error {
+	analyzeRows, err := db.QueryContext(ctx, infoSchemaAnalyzeStatusQuery)
+	if err != nil {
+		return err
+	}
+	defer analyzeRows.Close()
+
+	var (
+		database   string
+		table      string
+		state      string
+		jobs       float64
+		lastEndAge float64
+	)
+	jobStates := make(map[string]float64)
+	for _, state := range analyzeJobStates {
+		jobStates[state] = 0
+	}
+	for analyzeRows.Next() {
+		if err := analyzeRows.Scan(&database, &table, &state, &jobs, &lastEndAge); err != nil {
+			return err
+		}
+		if !collected(database) {
+			continue
+		}
+		jobStates[state] += jobs
+		if state == "finished" {
+			ch <- prometheus.MustNewConstMetric(analyzeLastSuccessAgeDesc, prometheus.GaugeValue, lastEndAge, database, table)
+		}
+	}
+	if err := analyzeRows.Err(); err != nil {
+		return err
+	}
+
+	for _, state := range sortedMapKeys(jobStates) {
+		ch <- prometheus.MustNewConstMetric(analyzeJobsDesc, prometheus.GaugeValue, jobStates[state], state)
+	}
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeStatsHealth{}
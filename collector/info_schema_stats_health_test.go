@@ -0,0 +1,114 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeStatsHealth(t *testing.T) {
+	testCases := []struct {
+		databases string
+		expected  []MetricResult
+	}{
+		{
+			databases: "*",
+			expected: []MetricResult{
+				{labels: labelMap{"schema": "shop", "table": "orders", "partition": ""}, value: 80, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "events", "partition": "p202610"}, value: 40, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "orders", "partition": ""}, value: 0.2, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "orders", "partition": ""}, value: 1792137600, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "events", "partition": "p202610"}, value: 0, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "events", "partition": "p202610"}, value: 1792137600, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "shop", "table": "orders"}, value: 3600, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "failed"}, value: 1, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "finished"}, value: 3, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "pending"}, value: 0, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "running"}, value: 1, metricType: dto.MetricType_GAUGE},
+			},
+		},
+		{
+			databases: "mysql",
+			expected: []MetricResult{
+				{labels: labelMap{"schema": "mysql", "table": "stats_meta", "partition": ""}, value: 100, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "mysql", "table": "stats_meta", "partition": ""}, value: 0, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"schema": "mysql", "table": "stats_meta", "partition": ""}, value: 1792137600, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "failed"}, value: 0, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "pending"}, value: 1, metricType: dto.MetricType_GAUGE},
+				{labels: labelMap{"state": "running"}, value: 0, metricType: dto.MetricType_GAUGE},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		_, err := kingpin.CommandLine.Parse([]string{"--collect.info_schema.stats_health.databases=" + test.databases})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("error opening a stub database connection: %s", err)
+		}
+
+		mock.ExpectQuery(sanitizeQuery(showStatsHealthyQuery)).WillReturnRows(
+			sqlmock.NewRows([]string{"Db_name", "Table_name", "Partition_name", "Healthy"}).
+				AddRow("mysql", "stats_meta", "", 100).
+				AddRow("shop", "orders", "", 80).
+				AddRow("shop", "events", "p202610", 40))
+		// 469798119014400000 is the TSO of 2026-10-16 08:00:00 UTC.
+		mock.ExpectQuery(sanitizeQuery(statsMetaQuery)).WillReturnRows(
+			sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "PARTITION_NAME", "version", "modify_count", "count"}).
+				AddRow("mysql", "stats_meta", "", uint64(469798119014400000), 0, 10).
+				AddRow("shop", "orders", "", uint64(469798119014400000), 200000, 1000000).
+				AddRow("shop", "events", "p202610", uint64(469798119014400000), 0, 0))
+		mock.ExpectQuery(sanitizeQuery(infoSchemaAnalyzeStatusQuery)).WillReturnRows(
+			sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "STATE", "JOBS", "LAST_END_AGE"}).
+				AddRow("mysql", "stats_meta", "pending", 1, 0).
+				AddRow("shop", "orders", "finished", 3, 3600).
+				AddRow("shop", "orders", "running", 1, 0).
+				AddRow("shop", "events", "failed", 1, 60))
+
+		ch := make(chan prometheus.Metric)
+		go func() {
+			if err = (ScrapeStatsHealth{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+				t.Errorf("error calling function on test: %s", err)
+			}
+			close(ch)
+		}()
+
+		convey.Convey("Metrics comparison for databases "+test.databases, t, func() {
+			for _, expect := range test.expected {
+				got := readMetric(<-ch)
+				convey.So(expect, convey.ShouldResemble, got)
+			}
+			_, ok := <-ch
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+
+		// Ensure all SQL queries were executed
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled exceptations: %s", err)
+		}
+		db.Close()
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.tidb_hot_regions` and `information_schema.tidb_hot_regions_history`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	infoSchemaHotRegionsQuery = `
+		  SELECT
+		    ifnull(DB_NAME, '') as DB_NAME,
+		    ifnull(TABLE_NAME, '') as TABLE_NAME,
+		    ifnull(INDEX_NAME, '') as INDEX_NAME,
+		    TYPE,
+		    SUM(FLOW_BYTES) as FLOW_BYTES,
+		    MAX(MAX_HOT_DEGREE) as MAX_HOT_DEGREE,
+		    COUNT(*) as REGIONS
+		  FROM information_schema.tidb_hot_regions
+		  GROUP BY DB_NAME, TABLE_NAME, INDEX_NAME, TYPE
+		  ORDER BY FLOW_BYTES DESC
+		`
+	// Only leaders are counted, followers report the same flow.
+	infoSchemaHotRegionsHistoryQuery = `
+		  SELECT
+		    ifnull(DB_NAME, '') as DB_NAME,
+		    ifnull(TABLE_NAME, '') as TABLE_NAME,
+		    ifnull(INDEX_NAME, '') as INDEX_NAME,
+		    TYPE,
+		    MAX(FLOW_BYTES) as FLOW_BYTES,
+		    MAX(HOT_DEGREE) as MAX_HOT_DEGREE,
+		    COUNT(DISTINCT REGION_ID) as REGIONS
+		  FROM information_schema.tidb_hot_regions_history
+		  WHERE UPDATE_TIME >= DATE_SUB(NOW(), INTERVAL %d SECOND)
+		    AND IS_LEADER = 1
+		  GROUP BY DB_NAME, TABLE_NAME, INDEX_NAME, TYPE
+		  ORDER BY FLOW_BYTES DESC
+		`
+)
+
+// Tunable flags.
+var (
+	hotRegionsLimit = kingpin.Flag(
+		"collect.info_schema.tidb_hot_regions.limit",
+		"Limit the number of hot tables and indexes by flow bytes, for each of read and write",
+	).Default("50").Int()
+	hotRegionsHistory = kingpin.Flag(
+		"collect.info_schema.tidb_hot_regions.history",
+		"Also collect hot regions recorded in tidb_hot_regions_history since the previous scrape",
+	).Default("false").Bool()
+	hotRegionsHistoryWindow = kingpin.Flag(
+		"collect.info_schema.tidb_hot_regions.history_window",
+		"How far back to read tidb_hot_regions_history on the first scrape of a target, in seconds",
+	).Default("60").Int()
+)
+
+var hotRegionsLabels = []string{"schema", "table", "index", "type"}
+
+// Metric descriptors.
+var (
+	hotRegionsFlowDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions_flow_bytes"),
+		"The flow bytes of the hot regions of a table or index.",
+		hotRegionsLabels, nil)
+	hotRegionsDegreeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions_max_hot_degree"),
+		"The maximum hot degree of the hot regions of a table or index.",
+		hotRegionsLabels, nil)
+	hotRegionsCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions"),
+		"The number of hot regions of a table or index.",
+		hotRegionsLabels, nil)
+	hotRegionsHistoryFlowDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions_history_max_flow_bytes"),
+		"The maximum flow bytes of a hot region of a table or index recorded since the previous scrape.",
+		hotRegionsLabels, nil)
+	hotRegionsHistoryDegreeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions_history_max_hot_degree"),
+		"The maximum hot degree of a hot region of a table or index recorded since the previous scrape.",
+		hotRegionsLabels, nil)
+	hotRegionsHistoryCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "hot_regions_history_regions"),
+		"The number of hot regions of a table or index recorded since the previous scrape.",
+		hotRegionsLabels, nil)
+)
+
+// hotRegionsState is kept between scrapes of a target.
+type hotRegionsState struct {
+	mu         sync.Mutex
+	lastScrape time.Time
+}
+
+func newHotRegionsState() interface{} {
+	return &hotRegionsState{}
+}
+
+// ScrapeHotRegions collects from `information_schema.tidb_hot_regions`.
+type ScrapeHotRegions struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeHotRegions) Name() string {
+	return informationSchema + ".tidb_hot_regions"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeHotRegions) Help() string {
+	return "Collect read and write flow of hot regions per table and index from information_schema.tidb_hot_regions"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeHotRegions) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (s ScrapeHotRegions) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	return s.ScrapeWithState(ctx, db, ch, NewState().Target(""), logger)
+}
+
+// ScrapeWithState collects data from database connection and sends it over channel as prometheus metric.
+// The history of hot regions is read since the previous scrape of the target.
+func (s ScrapeHotRegions) ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, targetState *TargetState, logger log.Logger) error {
+	if err := scrapeHotRegions(ctx, db, ch, infoSchemaHotRegionsQuery,
+		hotRegionsFlowDesc, hotRegionsDegreeDesc, hotRegionsCountDesc); err != nil {
+		return err
+	}
+	if !*hotRegionsHistory {
+		return nil
+	}
+
+	state := targetState.Load(s.Name(), newHotRegionsState).(*hotRegionsState)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	now := time.Now()
+	window := *hotRegionsHistoryWindow
+	if !state.lastScrape.IsZero() {
+		window = int(now.Sub(state.lastScrape).Seconds() + 0.5)
+	}
+	if err := scrapeHotRegions(ctx, db, ch, fmt.Sprintf(infoSchemaHotRegionsHistoryQuery, window),
+		hotRegionsHistoryFlowDesc, hotRegionsHistoryDegreeDesc, hotRegionsHistoryCountDesc); err != nil {
+		return err
+	}
+	state.lastScrape = now
+	return nil
+}
+
+// scrapeHotRegions sends the top hot tables and indexes of each type, the query must order them by flow.
+func scrapeHotRegions(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, query string, flowDesc, degreeDesc, countDesc *prometheus.Desc) error {
+	hotRegionsRows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer hotRegionsRows.Close()
+
+	var (
+		database   string
+		table      string
+		index      string
+		regionType string
+		flowBytes  float64
+		hotDegree  float64
+		regions    float64
+	)
+	typeCounts := make(map[string]int)
+	for hotRegionsRows.Next() {
+		err = hotRegionsRows.Scan(&database, &table, &index, &regionType, &flowBytes, &hotDegree, &regions)
+		if err != nil {
+			return err
+		}
+		if typeCounts[regionType] >= *hotRegionsLimit {
+			continue
+		}
+		typeCounts[regionType]++
+
+		ch <- prometheus.MustNewConstMetric(flowDesc, prometheus.GaugeValue, flowBytes, database, table, index, regionType)
+		ch <- prometheus.MustNewConstMetric(degreeDesc, prometheus.GaugeValue, hotDegree, database, table, index, regionType)
+		ch <- prometheus.MustNewConstMetric(countDesc, prometheus.GaugeValue, regions, database, table, index, regionType)
+	}
+	return hotRegionsRows.Err()
+}
+
+// check interface
+var _ StatefulScraper = ScrapeHotRegions{}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeHotRegions(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.tidb_hot_regions.limit=1",
+		"--collect.info_schema.tidb_hot_regions.history",
+		"--collect.info_schema.tidb_hot_regions.history_window=300",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"DB_NAME", "TABLE_NAME", "INDEX_NAME", "TYPE", "FLOW_BYTES", "MAX_HOT_DEGREE", "REGIONS"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("shop", "orders", "", "write", 8388608, 12, 3).
+		AddRow("shop", "orders", "idx_user", "read", 4194304, 5, 1).
+		AddRow("shop", "items", "", "write", 1048576, 2, 1)
+	mock.ExpectQuery(sanitizeQuery(infoSchemaHotRegionsQuery)).WillReturnRows(rows)
+	historyRows := sqlmock.NewRows(columns).
+		AddRow("shop", "items", "", "write", 2097152, 7, 2)
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaHotRegionsHistoryQuery, 300))).WillReturnRows(historyRows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeHotRegions{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	write := labelMap{"schema": "shop", "table": "orders", "index": "", "type": "write"}
+	read := labelMap{"schema": "shop", "table": "orders", "index": "idx_user", "type": "read"}
+	history := labelMap{"schema": "shop", "table": "items", "index": "", "type": "write"}
+	expected := []MetricResult{
+		{labels: write, value: 8388608, metricType: dto.MetricType_GAUGE},
+		{labels: write, value: 12, metricType: dto.MetricType_GAUGE},
+		{labels: write, value: 3, metricType: dto.MetricType_GAUGE},
+		{labels: read, value: 4194304, metricType: dto.MetricType_GAUGE},
+		{labels: read, value: 5, metricType: dto.MetricType_GAUGE},
+		{labels: read, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: history, value: 2097152, metricType: dto.MetricType_GAUGE},
+		{labels: history, value: 7, metricType: dto.MetricType_GAUGE},
+		{labels: history, value: 2, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
+
+func TestScrapeHotRegionsHistoryWindow(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.tidb_hot_regions.history",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"DB_NAME", "TABLE_NAME", "INDEX_NAME", "TYPE", "FLOW_BYTES", "MAX_HOT_DEGREE", "REGIONS"}
+	mock.ExpectQuery(sanitizeQuery(infoSchemaHotRegionsQuery)).WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaHotRegionsHistoryQuery, 60))).WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(sanitizeQuery(infoSchemaHotRegionsQuery)).WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaHotRegionsHistoryQuery, 0))).WillReturnRows(sqlmock.NewRows(columns))
+
+	// The second scrape of the same target reads the history since the first one.
+	targetState := NewState().Target("")
+	for i := 0; i < 2; i++ {
+		ch := make(chan prometheus.Metric)
+		go func() {
+			if err := (ScrapeHotRegions{}).ScrapeWithState(context.Background(), db, ch, targetState, log.NewNopLogger()); err != nil {
+				t.Errorf("error calling function on test: %s", err)
+			}
+			close(ch)
+		}()
+		for range ch {
+		}
+	}
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
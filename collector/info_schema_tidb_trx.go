@@ -0,0 +1,173 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.cluster_tidb_trx`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Open transactions, oldest first.
+const infoSchemaTiDBTrxQuery = `
+		  SELECT
+		    INSTANCE,
+		    ID,
+		    STATE,
+		    ifnull(USER, '') as USER,
+		    ifnull(DB, '') as DB,
+		    ifnull(CURRENT_SQL_DIGEST, '') as CURRENT_SQL_DIGEST,
+		    MEM_BUFFER_KEYS,
+		    MEM_BUFFER_BYTES,
+		    TIMESTAMPDIFF(MICROSECOND, START_TIME, NOW(6)) as AGE
+		  FROM information_schema.cluster_tidb_trx
+		  WHERE START_TIME <= DATE_SUB(NOW(6), INTERVAL %d SECOND)
+		  ORDER BY START_TIME
+		`
+
+// Tunable flags.
+var (
+	tidbTrxMinTime = kingpin.Flag(
+		"collect.info_schema.tidb_trx.min_time",
+		"Minimum time a transaction must have been open to be counted",
+	).Default("0").Int()
+	tidbTrxLimit = kingpin.Flag(
+		"collect.info_schema.tidb_trx.limit",
+		"Limit the number of oldest transactions to export the age of",
+	).Default("10").Int()
+)
+
+// Metric descriptors.
+var (
+	tidbTrxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tidb_trx"),
+		"The number of open transactions by instance and state.",
+		[]string{"instance", "state"}, nil)
+	tidbTrxOldestDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tidb_trx_oldest_seconds"),
+		"The age of the oldest open transaction by instance.",
+		[]string{"instance"}, nil)
+	tidbTrxMemBufferBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tidb_trx_max_mem_buffer_bytes"),
+		"The largest memory buffer of an open transaction by instance.",
+		[]string{"instance"}, nil)
+	tidbTrxMemBufferKeysDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tidb_trx_max_mem_buffer_keys"),
+		"The largest number of keys in the memory buffer of an open transaction by instance.",
+		[]string{"instance"}, nil)
+	tidbTrxAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tidb_trx_age_seconds"),
+		"The age of the oldest open transactions.",
+		[]string{"instance", "id", "user", "db", "digest"}, nil)
+)
+
+// tidbTrxInstance aggregates the open transactions of an instance.
+type tidbTrxInstance struct {
+	states         map[string]float64
+	oldest         float64
+	memBufferBytes float64
+	memBufferKeys  float64
+}
+
+// ScrapeTiDBTrx collects from `information_schema.cluster_tidb_trx`.
+type ScrapeTiDBTrx struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeTiDBTrx) Name() string {
+	return informationSchema + ".tidb_trx"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeTiDBTrx) Help() string {
+	return "Collect open transaction counts, age and memory buffer from information_schema.cluster_tidb_trx"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeTiDBTrx) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeTiDBTrx) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	trxRows, err := db.QueryContext(ctx, fmt.Sprintf(infoSchemaTiDBTrxQuery, *tidbTrxMinTime))
+	if err != nil {
+		return err
+	}
+	defer trxRows.Close()
+
+	var (
+		instance       string
+		id             string
+		state          string
+		user           string
+		database       string
+		digest         string
+		memBufferKeys  float64
+		memBufferBytes float64
+		age            float64
+		exported       int
+	)
+	instances := make(map[string]*tidbTrxInstance)
+	for trxRows.Next() {
+		err = trxRows.Scan(&instance, &id, &state, &user, &database, &digest, &memBufferKeys, &memBufferBytes, &age)
+		if err != nil {
+			return err
+		}
+		age /= 1e6
+
+		trx, ok := instances[instance]
+		if !ok {
+			trx = &tidbTrxInstance{states: make(map[string]float64)}
+			instances[instance] = trx
+		}
+		trx.states[state]++
+		if age > trx.oldest {
+			trx.oldest = age
+		}
+		if memBufferBytes > trx.memBufferBytes {
+			trx.memBufferBytes = memBufferBytes
+		}
+		if memBufferKeys > trx.memBufferKeys {
+			trx.memBufferKeys = memBufferKeys
+		}
+
+		if exported < *tidbTrxLimit {
+			exported++
+			ch <- prometheus.MustNewConstMetric(tidbTrxAgeDesc, prometheus.GaugeValue, age, instance, id, user, database, digest)
+		}
+	}
+	if err := trxRows.Err(); err != nil {
+		return err
+	}
+
+	for _, instance := range sortedMapKeys(instances) {
+		trx := instances[instance]
+		for _, state := range sortedMapKeys(trx.states) {
+			ch <- prometheus.MustNewConstMetric(tidbTrxDesc, prometheus.GaugeValue, trx.states[state], instance, state)
+		}
+		ch <- prometheus.MustNewConstMetric(tidbTrxOldestDesc, prometheus.GaugeValue, trx.oldest, instance)
+		ch <- prometheus.MustNewConstMetric(tidbTrxMemBufferBytesDesc, prometheus.GaugeValue, trx.memBufferBytes, instance)
+		ch <- prometheus.MustNewConstMetric(tidbTrxMemBufferKeysDesc, prometheus.GaugeValue, trx.memBufferKeys, instance)
+	}
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeTiDBTrx{}
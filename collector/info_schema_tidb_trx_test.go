@@ -0,0 +1,87 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeTiDBTrx(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.tidb_trx.min_time=60",
+		"--collect.info_schema.tidb_trx.limit=2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"INSTANCE", "ID", "STATE", "USER", "DB", "CURRENT_SQL_DIGEST", "MEM_BUFFER_KEYS", "MEM_BUFFER_BYTES", "AGE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("10.0.1.1:10080", "446913451452071937", "Idle", "app", "shop", "", 120, 65536, 7200000000).
+		AddRow("10.0.1.2:10080", "446913466525614081", "LockWaiting", "app", "shop", "e6f07d43", 1, 64, 600000000).
+		AddRow("10.0.1.1:10080", "446913482729619457", "Running", "batch", "", "a3c2f190", 5000, 1048576, 90500000)
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaTiDBTrxQuery, 60))).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeTiDBTrx{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	first := labelMap{"instance": "10.0.1.1:10080"}
+	second := labelMap{"instance": "10.0.1.2:10080"}
+	expected := []MetricResult{
+		{labels: labelMap{"instance": "10.0.1.1:10080", "id": "446913451452071937", "user": "app", "db": "shop", "digest": ""}, value: 7200, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"instance": "10.0.1.2:10080", "id": "446913466525614081", "user": "app", "db": "shop", "digest": "e6f07d43"}, value: 600, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"instance": "10.0.1.1:10080", "state": "Idle"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"instance": "10.0.1.1:10080", "state": "Running"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: first, value: 7200, metricType: dto.MetricType_GAUGE},
+		{labels: first, value: 1048576, metricType: dto.MetricType_GAUGE},
+		{labels: first, value: 5000, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"instance": "10.0.1.2:10080", "state": "LockWaiting"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: second, value: 600, metricType: dto.MetricType_GAUGE},
+		{labels: second, value: 64, metricType: dto.MetricType_GAUGE},
+		{labels: second, value: 1, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
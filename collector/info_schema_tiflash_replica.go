@@ -0,0 +1,115 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.tiflash_replica`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Replicas are reported by logical table, the progress of a partitioned table
+// is the average over its partitions.
+const infoSchemaTiFlashReplicaQuery = `
+		  SELECT
+		    TABLE_SCHEMA,
+		    TABLE_NAME,
+		    REPLICA_COUNT,
+		    AVAILABLE,
+		    PROGRESS
+		  FROM information_schema.tiflash_replica
+		`
+
+var tiflashReplicaLabels = []string{"schema", "table"}
+
+// Metric descriptors.
+var (
+	tiflashReplicaCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tiflash_replica_count"),
+		"The number of TiFlash replicas configured for a table.",
+		tiflashReplicaLabels, nil)
+	tiflashReplicaAvailableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tiflash_replica_available"),
+		"Whether the TiFlash replica of a table is available (1 for available, 0 for unavailable).",
+		tiflashReplicaLabels, nil)
+	tiflashReplicaProgressDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tiflash_replica_progress"),
+		"The replication progress of the TiFlash replica of a table, between 0 and 1, averaged over the partitions of a partitioned table.",
+		tiflashReplicaLabels, nil)
+	tiflashReplicasUnavailableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tiflash_replicas_unavailable"),
+		"The number of tables whose TiFlash replica is not available.",
+		nil, nil)
+)
+
+// ScrapeTiFlashReplica collects from `information_schema.tiflash_replica`.
+type ScrapeTiFlashReplica struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeTiFlashReplica) Name() string {
+	return informationSchema + ".tiflash_replica"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeTiFlashReplica) Help() string {
+	return "Collect TiFlash replica availability and progress per table from information_schema.tiflash_replica"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeTiFlashReplica) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeTiFlashReplica) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	replicaRows, err := db.QueryContext(ctx, infoSchemaTiFlashReplicaQuery)
+	if err != nil {
+		return err
+	}
+	defer replicaRows.Close()
+
+	var (
+		database     string
+		table        string
+		replicaCount float64
+		available    float64
+		progress     float64
+		unavailable  float64
+	)
+	for replicaRows.Next() {
+		err = replicaRows.Scan(&database, &table, &replicaCount, &available, &progress)
+		if err != nil {
+			return err
+		}
+		if available == 0 {
+			unavailable++
+		}
+		ch <- prometheus.MustNewConstMetric(tiflashReplicaCountDesc, prometheus.GaugeValue, replicaCount, database, table)
+		ch <- prometheus.MustNewConstMetric(tiflashReplicaAvailableDesc, prometheus.GaugeValue, available, database, table)
+		ch <- prometheus.MustNewConstMetric(tiflashReplicaProgressDesc, prometheus.GaugeValue, progress, database, table)
+	}
+	if err := replicaRows.Err(); err != nil {
+		return err
+	}
+
+	ch <- prometheus.MustNewConstMetric(tiflashReplicasUnavailableDesc, prometheus.GaugeValue, unavailable)
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeTiFlashReplica{}
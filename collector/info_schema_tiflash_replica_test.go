@@ -1,4 +1,4 @@
-// Copyright 2018 The Prometheus Authors
+// Copyright 2026 The Prometheus Authors
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -24,44 +24,49 @@ import (
 	"github.com/smartystreets/goconvey/convey"
 )
 
-func TestScrapeBinlogSize(t *testing.T) {
+func TestScrapeTiFlashReplica(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("error opening a stub database connection: %s", err)
 	}
 	defer db.Close()
 
-	mock.ExpectQuery(logbinQuery).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(1))
-
-	columns := []string{"Log_name", "File_size"}
+	columns := []string{"TABLE_SCHEMA", "TABLE_NAME", "REPLICA_COUNT", "AVAILABLE", "PROGRESS"}
 	rows := sqlmock.NewRows(columns).
-		AddRow("centos6-bin.000001", "1813").
-		AddRow("centos6-bin.000002", "120").
-		AddRow("centos6-bin.000444", "573009")
-	mock.ExpectQuery(sanitizeQuery(binlogQuery)).WillReturnRows(rows)
+		AddRow("shop", "orders", 2, 1, 1).
+		AddRow("shop", "events", 1, 0, 0.25)
+	mock.ExpectQuery(sanitizeQuery(infoSchemaTiFlashReplicaQuery)).WillReturnRows(rows)
 
 	ch := make(chan prometheus.Metric)
 	go func() {
-		if err = (ScrapeBinlogSize{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+		if err = (ScrapeTiFlashReplica{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
 			t.Errorf("error calling function on test: %s", err)
 		}
 		close(ch)
 	}()
 
-	counterExpected := []MetricResult{
-		{labels: labelMap{}, value: 574942, metricType: dto.MetricType_GAUGE},
-		{labels: labelMap{}, value: 3, metricType: dto.MetricType_GAUGE},
-		{labels: labelMap{}, value: 444, metricType: dto.MetricType_GAUGE},
+	orders := labelMap{"schema": "shop", "table": "orders"}
+	events := labelMap{"schema": "shop", "table": "events"}
+	expected := []MetricResult{
+		{labels: orders, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: orders, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: orders, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 0.25, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 1, metricType: dto.MetricType_GAUGE},
 	}
 	convey.Convey("Metrics comparison", t, func() {
-		for _, expect := range counterExpected {
+		for _, expect := range expected {
 			got := readMetric(<-ch)
-			convey.So(got, convey.ShouldResemble, expect)
+			convey.So(expect, convey.ShouldResemble, got)
 		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
 	})
 
 	// Ensure all SQL queries were executed
 	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled exceptions: %s", err)
+		t.Errorf("there were unfulfilled exceptations: %s", err)
 	}
 }
@@ -0,0 +1,161 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.tikv_region_status`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// APPROXIMATE_SIZE is in MiB. The databases are filtered in the query, so
+// regions of other databases are not aggregated.
+const infoSchemaTiKVRegionStatusQuery = `
+		  SELECT
+		    ifnull(DB_NAME, '') as DB_NAME,
+		    ifnull(TABLE_NAME, '') as TABLE_NAME,
+		    IS_INDEX,
+		    ifnull(INDEX_NAME, '') as INDEX_NAME,
+		    COUNT(*) as REGIONS,
+		    SUM(APPROXIMATE_KEYS = 0) as EMPTY_REGIONS,
+		    SUM(APPROXIMATE_SIZE) as APPROXIMATE_SIZE,
+		    SUM(APPROXIMATE_KEYS) as APPROXIMATE_KEYS,
+		    MAX(APPROXIMATE_SIZE) as MAX_APPROXIMATE_SIZE
+		  FROM information_schema.tikv_region_status
+		  %s
+		  GROUP BY DB_NAME, TABLE_NAME, IS_INDEX, INDEX_NAME
+		`
+
+// Tunable flags.
+var (
+	regionStatusDatabases = kingpin.Flag(
+		"collect.info_schema.tikv_region_status.databases",
+		"The list of databases to collect region stats for, or '*' for all",
+	).Default("*").String()
+	regionStatusExcludeDatabases = kingpin.Flag(
+		"collect.info_schema.tikv_region_status.exclude_databases",
+		"The list of databases not to collect region stats for, '*' is not accepted",
+	).Default("mysql").String()
+)
+
+const mebiBytes = 1024 * 1024
+
+var regionStatusLabels = []string{"schema", "table", "is_index", "index"}
+
+// Metric descriptors.
+var (
+	regionStatusRegionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "table_regions"),
+		"The number of regions of a table or index.",
+		regionStatusLabels, nil)
+	regionStatusEmptyRegionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "table_empty_regions"),
+		"The number of regions of a table or index without keys.",
+		regionStatusLabels, nil)
+	regionStatusSizeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "table_regions_approximate_size_bytes"),
+		"The approximate size of the regions of a table or index.",
+		regionStatusLabels, nil)
+	regionStatusKeysDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "table_regions_approximate_keys"),
+		"The approximate number of keys in the regions of a table or index.",
+		regionStatusLabels, nil)
+	regionStatusMaxSizeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "table_region_max_approximate_size_bytes"),
+		"The approximate size of the largest region of a table or index.",
+		regionStatusLabels, nil)
+)
+
+// ScrapeTiKVRegionStatus collects from `information_schema.tikv_region_status`.
+type ScrapeTiKVRegionStatus struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeTiKVRegionStatus) Name() string {
+	return informationSchema + ".tikv_region_status"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeTiKVRegionStatus) Help() string {
+	return "Collect region distribution per table and index from information_schema.tikv_region_status"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeTiKVRegionStatus) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeTiKVRegionStatus) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	if strings.TrimSpace(*regionStatusExcludeDatabases) == "*" {
+		return fmt.Errorf("collect.info_schema.tikv_region_status.exclude_databases does not accept '*'")
+	}
+
+	var conditions []string
+	if databases := newListFilter(*regionStatusDatabases); databases != nil {
+		conditions = append(conditions, "DB_NAME IN ("+databases.sqlList()+")")
+	}
+	if excludeDatabases := newListFilter(*regionStatusExcludeDatabases); len(excludeDatabases) > 0 {
+		// Regions not belonging to a table have no database and are kept.
+		conditions = append(conditions, "(DB_NAME IS NULL OR DB_NAME NOT IN ("+excludeDatabases.sqlList()+"))")
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	regionStatusRows, err := db.QueryContext(ctx, fmt.Sprintf(infoSchemaTiKVRegionStatusQuery, where))
+	if err != nil {
+		return err
+	}
+	defer regionStatusRows.Close()
+
+	var (
+		database     string
+		table        string
+		isIndex      string
+		index        string
+		regions      uint64
+		emptyRegions uint64
+		size         float64
+		keys         float64
+		maxSize      float64
+	)
+	for regionStatusRows.Next() {
+		err = regionStatusRows.Scan(&database, &table, &isIndex, &index, &regions, &emptyRegions, &size, &keys, &maxSize)
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(regionStatusRegionsDesc, prometheus.GaugeValue, float64(regions),
+			database, table, isIndex, index)
+		ch <- prometheus.MustNewConstMetric(regionStatusEmptyRegionsDesc, prometheus.GaugeValue, float64(emptyRegions),
+			database, table, isIndex, index)
+		ch <- prometheus.MustNewConstMetric(regionStatusSizeDesc, prometheus.GaugeValue, size*mebiBytes,
+			database, table, isIndex, index)
+		ch <- prometheus.MustNewConstMetric(regionStatusKeysDesc, prometheus.GaugeValue, keys,
+			database, table, isIndex, index)
+		ch <- prometheus.MustNewConstMetric(regionStatusMaxSizeDesc, prometheus.GaugeValue, maxSize*mebiBytes,
+			database, table, isIndex, index)
+	}
+	return regionStatusRows.Err()
+}
+
+// check interface
+var _ Scraper = ScrapeTiKVRegionStatus{}
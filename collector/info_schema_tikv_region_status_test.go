@@ -0,0 +1,113 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestScrapeTiKVRegionStatus(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.tikv_region_status.databases=shop,o'shop",
+		"--collect.info_schema.tikv_region_status.exclude_databases=mysql,test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"DB_NAME", "TABLE_NAME", "IS_INDEX", "INDEX_NAME", "REGIONS", "EMPTY_REGIONS",
+		"APPROXIMATE_SIZE", "APPROXIMATE_KEYS", "MAX_APPROXIMATE_SIZE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("shop", "orders", 0, "", 12, 2, 960, 4800000, 144).
+		AddRow("shop", "orders", 1, "idx_user", 3, 0, 150, 4800000, 64)
+	where := "WHERE DB_NAME IN ('o''shop', 'shop') AND (DB_NAME IS NULL OR DB_NAME NOT IN ('mysql', 'test'))"
+	mock.ExpectQuery(sanitizeQuery(fmt.Sprintf(infoSchemaTiKVRegionStatusQuery, where))).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeTiKVRegionStatus{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	table := labelMap{"schema": "shop", "table": "orders", "is_index": "0", "index": ""}
+	index := labelMap{"schema": "shop", "table": "orders", "is_index": "1", "index": "idx_user"}
+	expected := []MetricResult{
+		{labels: table, value: 12, metricType: dto.MetricType_GAUGE},
+		{labels: table, value: 2, metricType: dto.MetricType_GAUGE},
+		{labels: table, value: 960 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+		{labels: table, value: 4800000, metricType: dto.MetricType_GAUGE},
+		{labels: table, value: 144 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+		{labels: index, value: 3, metricType: dto.MetricType_GAUGE},
+		{labels: index, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: index, value: 150 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+		{labels: index, value: 4800000, metricType: dto.MetricType_GAUGE},
+		{labels: index, value: 64 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
+
+func TestScrapeTiKVRegionStatusExcludeAll(t *testing.T) {
+	_, err := kingpin.CommandLine.Parse([]string{
+		"--collect.info_schema.tikv_region_status.exclude_databases=*",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	ch := make(chan prometheus.Metric)
+	convey.Convey("Excluding all databases is rejected", t, func() {
+		err := (ScrapeTiKVRegionStatus{}).Scrape(context.Background(), db, ch, log.NewNopLogger())
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	// Ensure no SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `information_schema.tikv_store_status`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const infoSchemaTiKVStoreStatusQuery = `
+		  SELECT
+		    STORE_ID,
+		    ADDRESS,
+		    STORE_STATE_NAME,
+		    ifnull(LABEL, '') as LABEL,
+		    VERSION,
+		    CAPACITY,
+		    AVAILABLE,
+		    LEADER_COUNT,
+		    LEADER_WEIGHT,
+		    LEADER_SCORE,
+		    REGION_COUNT,
+		    REGION_WEIGHT,
+		    REGION_SCORE,
+		    TIMESTAMPDIFF(SECOND, LAST_HEARTBEAT_TS, NOW()) as LAST_HEARTBEAT_AGE
+		  FROM information_schema.tikv_store_status
+		`
+
+// States a store can be in, exported as an enum.
+var tikvStoreStates = []string{"Up", "Disconnected", "Offline", "Tombstone"}
+
+var tikvStoreLabels = []string{"store_id", "address"}
+
+// Metric descriptors.
+var (
+	tikvStoreInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_info"),
+		"Information about a TiKV store.",
+		[]string{"store_id", "address", "labels", "version"}, nil)
+	tikvStoreStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_state"),
+		"Whether a TiKV store is in a state.",
+		[]string{"store_id", "address", "state"}, nil)
+	tikvStoreCapacityDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_capacity_bytes"),
+		"The capacity of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreAvailableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_available_bytes"),
+		"The available space of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreLeadersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_leaders"),
+		"The number of leaders on a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreLeaderWeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_leader_weight"),
+		"The leader weight of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreLeaderScoreDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_leader_score"),
+		"The leader score of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreRegionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_regions"),
+		"The number of regions on a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreRegionWeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_region_weight"),
+		"The region weight of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreRegionScoreDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_region_score"),
+		"The region score of a TiKV store.",
+		tikvStoreLabels, nil)
+	tikvStoreHeartbeatAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, informationSchema, "tikv_store_last_heartbeat_age_seconds"),
+		"The number of seconds since the last heartbeat of a TiKV store.",
+		tikvStoreLabels, nil)
+)
+
+// ScrapeTiKVStoreStatus collects from `information_schema.tikv_store_status`.
+type ScrapeTiKVStoreStatus struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeTiKVStoreStatus) Name() string {
+	return informationSchema + ".tikv_store_status"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeTiKVStoreStatus) Help() string {
+	return "Collect state, capacity, leaders and regions per store from information_schema.tikv_store_status"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeTiKVStoreStatus) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeTiKVStoreStatus) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	storeStatusRows, err := db.QueryContext(ctx, infoSchemaTiKVStoreStatusQuery)
+	if err != nil {
+		return err
+	}
+	defer storeStatusRows.Close()
+
+	var (
+		storeID      string
+		address      string
+		state        string
+		label        string
+		version      string
+		capacity     string
+		available    string
+		leaders      float64
+		leaderWeight float64
+		leaderScore  float64
+		regions      float64
+		regionWeight float64
+		regionScore  float64
+		heartbeatAge sql.NullFloat64
+	)
+	for storeStatusRows.Next() {
+		err = storeStatusRows.Scan(
+			&storeID, &address, &state, &label, &version, &capacity, &available,
+			&leaders, &leaderWeight, &leaderScore, &regions, &regionWeight, &regionScore, &heartbeatAge,
+		)
+		if err != nil {
+			return err
+		}
+
+		ch <- prometheus.MustNewConstMetric(tikvStoreInfoDesc, prometheus.GaugeValue, 1,
+			storeID, address, formatStoreLabels(label), version)
+
+		known := false
+		for _, s := range tikvStoreStates {
+			value := 0.0
+			if s == state {
+				value, known = 1, true
+			}
+			ch <- prometheus.MustNewConstMetric(tikvStoreStateDesc, prometheus.GaugeValue, value, storeID, address, s)
+		}
+		if !known {
+			ch <- prometheus.MustNewConstMetric(tikvStoreStateDesc, prometheus.GaugeValue, 1, storeID, address, state)
+		}
+
+		if value, ok := parseByteSize(capacity); ok {
+			ch <- prometheus.MustNewConstMetric(tikvStoreCapacityDesc, prometheus.GaugeValue, value, storeID, address)
+		} else {
+			level.Debug(logger).Log("msg", "Failed to parse store capacity", "store_id", storeID, "capacity", capacity)
+		}
+		if value, ok := parseByteSize(available); ok {
+			ch <- prometheus.MustNewConstMetric(tikvStoreAvailableDesc, prometheus.GaugeValue, value, storeID, address)
+		} else {
+			level.Debug(logger).Log("msg", "Failed to parse store available", "store_id", storeID, "available", available)
+		}
+		ch <- prometheus.MustNewConstMetric(tikvStoreLeadersDesc, prometheus.GaugeValue, leaders, storeID, address)
+		ch <- prometheus.MustNewConstMetric(tikvStoreLeaderWeightDesc, prometheus.GaugeValue, leaderWeight, storeID, address)
+		ch <- prometheus.MustNewConstMetric(tikvStoreLeaderScoreDesc, prometheus.GaugeValue, leaderScore, storeID, address)
+		ch <- prometheus.MustNewConstMetric(tikvStoreRegionsDesc, prometheus.GaugeValue, regions, storeID, address)
+		ch <- prometheus.MustNewConstMetric(tikvStoreRegionWeightDesc, prometheus.GaugeValue, regionWeight, storeID, address)
+		ch <- prometheus.MustNewConstMetric(tikvStoreRegionScoreDesc, prometheus.GaugeValue, regionScore, storeID, address)
+		if heartbeatAge.Valid {
+			ch <- prometheus.MustNewConstMetric(tikvStoreHeartbeatAgeDesc, prometheus.GaugeValue, heartbeatAge.Float64, storeID, address)
+		}
+	}
+	return storeStatusRows.Err()
+}
+
+// formatStoreLabels turns the JSON store labels into a "key=value,..." string.
+func formatStoreLabels(label string) string {
+	var labels []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(label), &labels); err != nil {
+		return label
+	}
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, l.Key+"="+l.Value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+var byteSizeRE = regexp.MustCompile(`^([0-9.]+)\s*([KMGTPE]?)(i?)B$`)
+
+// parseByteSize parses human readable sizes as reported by PD, e.g. "1.5TiB".
+func parseByteSize(data string) (float64, bool) {
+	match := byteSizeRE.FindStringSubmatch(strings.TrimSpace(data))
+	if match == nil {
+		value, err := strconv.ParseFloat(data, 64)
+		return value, err == nil
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	base := 1000.0
+	if match[3] == "i" {
+		base = 1024
+	}
+	for i := strings.Index("KMGTPE", match[2]); match[2] != "" && i >= 0; i-- {
+		value *= base
+	}
+	return value, true
+}
+
+// check interface
+var _ Scraper = ScrapeTiKVStoreStatus{}
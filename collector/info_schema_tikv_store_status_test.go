@@ -0,0 +1,94 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeTiKVStoreStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"STORE_ID", "ADDRESS", "STORE_STATE_NAME", "LABEL", "VERSION", "CAPACITY", "AVAILABLE",
+		"LEADER_COUNT", "LEADER_WEIGHT", "LEADER_SCORE", "REGION_COUNT", "REGION_WEIGHT", "REGION_SCORE", "LAST_HEARTBEAT_AGE"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("1", "tikv-0:20160", "Up", `[{"key": "zone", "value": "z1"}, {"key": "host", "value": "h1"}]`, "7.5.0",
+			"1.5TiB", "512GiB", 1024, 1, 1024, 3072, 1, 250000.5, 3).
+		AddRow("7", "tikv-1:20160", "Disconnected", "null", "7.5.0", "0B", "0B", 0, 1, 0, 0, 1, 0, nil)
+	mock.ExpectQuery(sanitizeQuery(infoSchemaTiKVStoreStatusQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeTiKVStoreStatus{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	store1 := labelMap{"store_id": "1", "address": "tikv-0:20160"}
+	store7 := labelMap{"store_id": "7", "address": "tikv-1:20160"}
+	expected := []MetricResult{
+		{labels: labelMap{"store_id": "1", "address": "tikv-0:20160", "labels": "zone=z1,host=h1", "version": "7.5.0"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "1", "address": "tikv-0:20160", "state": "Up"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "1", "address": "tikv-0:20160", "state": "Disconnected"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "1", "address": "tikv-0:20160", "state": "Offline"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "1", "address": "tikv-0:20160", "state": "Tombstone"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 1.5 * 1024 * 1024 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 512 * 1024 * 1024 * 1024, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 1024, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 1024, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 3072, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 250000.5, metricType: dto.MetricType_GAUGE},
+		{labels: store1, value: 3, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "7", "address": "tikv-1:20160", "labels": "", "version": "7.5.0"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "7", "address": "tikv-1:20160", "state": "Up"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "7", "address": "tikv-1:20160", "state": "Disconnected"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "7", "address": "tikv-1:20160", "state": "Offline"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"store_id": "7", "address": "tikv-1:20160", "state": "Tombstone"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: store7, value: 0, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptions: %s", err)
+	}
+}
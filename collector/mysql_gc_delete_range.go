@@ -0,0 +1,115 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `mysql.gc_delete_range` and `mysql.gc_delete_range_done`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Delete ranges only record their DDL job, the job type is taken from the job.
+const mysqlGCDeleteRangeQuery = `
+		  SELECT
+		    'pending' as STATE,
+		    ifnull(jobs.JOB_TYPE, '') as JOB_TYPE,
+		    COUNT(*) as RANGES,
+		    MIN(ranges.ts) as OLDEST_TS,
+		    UNIX_TIMESTAMP(NOW(6)) as NOW
+		  FROM mysql.gc_delete_range ranges
+		  LEFT JOIN information_schema.ddl_jobs jobs ON jobs.JOB_ID = ranges.job_id
+		  GROUP BY JOB_TYPE
+		  UNION ALL
+		  SELECT
+		    'done' as STATE,
+		    ifnull(jobs.JOB_TYPE, '') as JOB_TYPE,
+		    COUNT(*) as RANGES,
+		    MIN(ranges.ts) as OLDEST_TS,
+		    UNIX_TIMESTAMP(NOW(6)) as NOW
+		  FROM mysql.gc_delete_range_done ranges
+		  LEFT JOIN information_schema.ddl_jobs jobs ON jobs.JOB_ID = ranges.job_id
+		  GROUP BY JOB_TYPE
+		`
+
+// Metric descriptors.
+var (
+	gcDeleteRangesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "delete_ranges"),
+		"The number of delete ranges pending GC and done by the type of the DDL job which dropped them.",
+		[]string{"state", "job_type"}, nil)
+	gcDeleteRangeOldestPendingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "delete_range_oldest_pending_seconds"),
+		"The age of the oldest delete range pending GC, 0 when there is none.",
+		nil, nil)
+)
+
+// ScrapeGCDeleteRange collects from `mysql.gc_delete_range` and `mysql.gc_delete_range_done`.
+type ScrapeGCDeleteRange struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeGCDeleteRange) Name() string {
+	return mysql + ".gc_delete_range"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeGCDeleteRange) Help() string {
+	return "Collect the backlog of delete ranges pending GC from mysql.gc_delete_range and mysql.gc_delete_range_done"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeGCDeleteRange) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeGCDeleteRange) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	rangeRows, err := db.QueryContext(ctx, mysqlGCDeleteRangeQuery)
+	if err != nil {
+		return err
+	}
+	defer rangeRows.Close()
+
+	var (
+		state         string
+		jobType       string
+		ranges        float64
+		oldestTS      uint64
+		now           float64
+		oldestPending float64
+	)
+	for rangeRows.Next() {
+		if err := rangeRows.Scan(&state, &jobType, &ranges, &oldestTS, &now); err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(gcDeleteRangesDesc, prometheus.GaugeValue, ranges, state, jobType)
+
+		// The ts is a TSO, its physical part is in milliseconds.
+		if age := now - float64(oldestTS>>18)/1e3; state == "pending" && age > oldestPending {
+			oldestPending = age
+		}
+	}
+	if err := rangeRows.Err(); err != nil {
+		return err
+	}
+
+	ch <- prometheus.MustNewConstMetric(gcDeleteRangeOldestPendingDesc, prometheus.GaugeValue, oldestPending)
+	return nil
+}
+
+// check interface
+var _ Scraper = ScrapeGCDeleteRange{}
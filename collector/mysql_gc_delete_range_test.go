@@ -1,4 +1,4 @@
-// Copyright 2018 The Prometheus Authors
+// Copyright 2026 The Prometheus Authors
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -24,41 +24,47 @@ import (
 	"github.com/smartystreets/goconvey/convey"
 )
 
-func TestScrapeSlaveStatus(t *testing.T) {
+func TestScrapeGCDeleteRange(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("error opening a stub database connection: %s", err)
 	}
 	defer db.Close()
 
-	columns := []string{"Master_Host", "Read_Master_Log_Pos", "Slave_IO_Running", "Slave_SQL_Running", "Seconds_Behind_Master"}
+	// The TSOs are an hour and a minute before the time of the server.
+	now := 1792116000.0
+	columns := []string{"STATE", "JOB_TYPE", "RANGES", "OLDEST_TS", "NOW"}
 	rows := sqlmock.NewRows(columns).
-		AddRow("127.0.0.1", "1", "Connecting", "Yes", "2")
-	mock.ExpectQuery(sanitizeQuery("SHOW SLAVE STATUS")).WillReturnRows(rows)
+		AddRow("pending", "drop table", 12, uint64(469791512985600000), now).
+		AddRow("pending", "truncate table", 3, uint64(469792440975360000), now).
+		AddRow("done", "drop table", 40, uint64(469791512985600000), now)
+	mock.ExpectQuery(sanitizeQuery(mysqlGCDeleteRangeQuery)).WillReturnRows(rows)
 
 	ch := make(chan prometheus.Metric)
 	go func() {
-		if err = (ScrapeSlaveStatus{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+		if err = (ScrapeGCDeleteRange{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
 			t.Errorf("error calling function on test: %s", err)
 		}
 		close(ch)
 	}()
 
-	counterExpected := []MetricResult{
-		{labels: labelMap{"channel_name": "", "connection_name": "", "master_host": "127.0.0.1", "master_uuid": ""}, value: 1, metricType: dto.MetricType_UNTYPED},
-		{labels: labelMap{"channel_name": "", "connection_name": "", "master_host": "127.0.0.1", "master_uuid": ""}, value: 0, metricType: dto.MetricType_UNTYPED},
-		{labels: labelMap{"channel_name": "", "connection_name": "", "master_host": "127.0.0.1", "master_uuid": ""}, value: 1, metricType: dto.MetricType_UNTYPED},
-		{labels: labelMap{"channel_name": "", "connection_name": "", "master_host": "127.0.0.1", "master_uuid": ""}, value: 2, metricType: dto.MetricType_UNTYPED},
+	expected := []MetricResult{
+		{labels: labelMap{"state": "pending", "job_type": "drop table"}, value: 12, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"state": "pending", "job_type": "truncate table"}, value: 3, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"state": "done", "job_type": "drop table"}, value: 40, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 3600, metricType: dto.MetricType_GAUGE},
 	}
 	convey.Convey("Metrics comparison", t, func() {
-		for _, expect := range counterExpected {
+		for _, expect := range expected {
 			got := readMetric(<-ch)
-			convey.So(got, convey.ShouldResemble, expect)
+			convey.So(expect, convey.ShouldResemble, got)
 		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
 	})
 
 	// Ensure all SQL queries were executed
 	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled exceptions: %s", err)
+		t.Errorf("there were unfulfilled exceptations: %s", err)
 	}
 }
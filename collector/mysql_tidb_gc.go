@@ -0,0 +1,155 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape GC status from `mysql.tidb`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Subsystem.
+	gc = "gc"
+	// The time of the server is read along with the GC status to compute the lags.
+	mysqlTiDBGCQuery = `
+		  SELECT
+		    VARIABLE_NAME,
+		    VARIABLE_VALUE,
+		    UNIX_TIMESTAMP(NOW(6)) as NOW
+		  FROM mysql.tidb
+		  WHERE VARIABLE_NAME IN (
+		    'tikv_gc_safe_point',
+		    'tikv_gc_last_run_time',
+		    'tikv_gc_life_time',
+		    'tikv_gc_leader_uuid',
+		    'tikv_gc_leader_desc',
+		    'tikv_gc_enable'
+		  )
+		`
+)
+
+// Metric descriptors.
+var (
+	gcSafePointDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "safe_point_timestamp_seconds"),
+		"The GC safe point, data older than it may have been collected.",
+		nil, nil)
+	gcSafePointLagDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "safe_point_lag_seconds"),
+		"The time the GC safe point is behind the time of the server.",
+		nil, nil)
+	gcLastRunDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "seconds_since_last_run"),
+		"The time since GC last ran.",
+		nil, nil)
+	gcLifeTimeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "life_time_seconds"),
+		"The configured time for which data is retained before it may be collected.",
+		nil, nil)
+	gcLeaderDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "leader_info"),
+		"The TiDB instance which is the GC leader, the value is always 1.",
+		[]string{"uuid", "host"}, nil)
+	gcEnabledDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, gc, "enabled"),
+		"Whether GC is enabled (1 for enabled, 0 for disabled).",
+		nil, nil)
+)
+
+// ScrapeGC collects GC status from `mysql.tidb`.
+type ScrapeGC struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeGC) Name() string {
+	return mysql + ".tidb_gc"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeGC) Help() string {
+	return "Collect GC safe point, last run, life time, leader and whether it is enabled from mysql.tidb"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeGC) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeGC) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	gcRows, err := db.QueryContext(ctx, mysqlTiDBGCQuery)
+	if err != nil {
+		return err
+	}
+	defer gcRows.Close()
+
+	var (
+		name  string
+		value sql.RawBytes
+		now   float64
+	)
+	variables := make(map[string]sql.RawBytes)
+	for gcRows.Next() {
+		if err := gcRows.Scan(&name, &value, &now); err != nil {
+			return err
+		}
+		variables[name] = append(sql.RawBytes{}, value...)
+	}
+	if err := gcRows.Err(); err != nil {
+		return err
+	}
+
+	// Silently skip missing and unparsable values.
+	if safePoint, ok := parseStatus(variables["tikv_gc_safe_point"]); ok {
+		ch <- prometheus.MustNewConstMetric(gcSafePointDesc, prometheus.GaugeValue, safePoint)
+		ch <- prometheus.MustNewConstMetric(gcSafePointLagDesc, prometheus.GaugeValue, now-safePoint)
+	}
+	if lastRun, ok := parseStatus(variables["tikv_gc_last_run_time"]); ok {
+		ch <- prometheus.MustNewConstMetric(gcLastRunDesc, prometheus.GaugeValue, now-lastRun)
+	}
+	if lifeTime, ok := parseStatus(variables["tikv_gc_life_time"]); ok {
+		ch <- prometheus.MustNewConstMetric(gcLifeTimeDesc, prometheus.GaugeValue, lifeTime)
+	}
+	if uuid, ok := variables["tikv_gc_leader_uuid"]; ok {
+		ch <- prometheus.MustNewConstMetric(gcLeaderDesc, prometheus.GaugeValue, 1,
+			string(uuid), parseGCLeaderHost(string(variables["tikv_gc_leader_desc"])))
+	}
+	if enabled, err := strconv.ParseBool(string(variables["tikv_gc_enable"])); err == nil {
+		value := 0.0
+		if enabled {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(gcEnabledDesc, prometheus.GaugeValue, value)
+	}
+	return nil
+}
+
+// parseGCLeaderHost returns the host of a GC leader description, such as "host:tidb-0, pid:1, start at ...".
+func parseGCLeaderHost(desc string) string {
+	for _, field := range strings.Split(desc, ",") {
+		if field = strings.TrimSpace(field); strings.HasPrefix(field, "host:") {
+			return strings.TrimPrefix(field, "host:")
+		}
+	}
+	return desc
+}
+
+// check interface
+var _ Scraper = ScrapeGC{}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeGC(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	// The server time is 2026-10-16 10:00:00 +0800.
+	now := 1792116000.5
+	columns := []string{"VARIABLE_NAME", "VARIABLE_VALUE", "NOW"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("tikv_gc_leader_uuid", "63e4a2d6a8c0004", now).
+		AddRow("tikv_gc_leader_desc", "host:tidb-0, pid:2804, start at 2026-10-01 08:00:00.123 +0800 CST m=+0.031", now).
+		AddRow("tikv_gc_last_run_time", "20261016-09:59:00.500 +0800", now).
+		// Older versions append the zone name.
+		AddRow("tikv_gc_safe_point", "20261016-09:50:00 +0800 CST", now).
+		AddRow("tikv_gc_life_time", "10m0s", now).
+		AddRow("tikv_gc_enable", "true", now)
+	mock.ExpectQuery(sanitizeQuery(mysqlTiDBGCQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeGC{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	expected := []MetricResult{
+		{labels: labelMap{}, value: 1792115400, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 600.5, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 60, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 600, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"uuid": "63e4a2d6a8c0004", "host": "tidb-0"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{}, value: 1, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Scrape `mysql.tidb_ttl_table_status` and `mysql.tidb_ttl_job_history`.
+
+package collector
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Subsystem.
+const ttl = "ttl"
+
+// The status rows are per physical table, a partition of a partitioned table
+// has its own row whose parent_table_id is the logical table.
+const mysqlTiDBTTLQuery = `
+		  SELECT
+		    tables.TABLE_SCHEMA,
+		    tables.TABLE_NAME,
+		    ifnull(partitions.PARTITION_NAME, '') as PARTITION_NAME,
+		    ifnull(status.current_job_status, '') as CURRENT_JOB_STATUS,
+		    UNIX_TIMESTAMP(success.FINISH_TIME) as LAST_SUCCESS_TIME,
+		    last_job.expired_rows,
+		    last_job.deleted_rows,
+		    last_job.error_delete_rows,
+		    UNIX_TIMESTAMP(NOW(6)) - UNIX_TIMESTAMP(ifnull(status.current_job_ttl_expire, status.last_job_ttl_expire)) as EXPIRE_LAG
+		  FROM mysql.tidb_ttl_table_status status
+		  JOIN information_schema.tables tables ON tables.TIDB_TABLE_ID = status.parent_table_id
+		  LEFT JOIN information_schema.partitions partitions ON partitions.TIDB_PARTITION_ID = status.table_id
+		  LEFT JOIN mysql.tidb_ttl_job_history last_job ON last_job.job_id = status.last_job_id
+		  LEFT JOIN (
+		    SELECT table_id, MAX(finish_time) as FINISH_TIME
+		    FROM mysql.tidb_ttl_job_history
+		    WHERE status = 'finished'
+		    GROUP BY table_id
+		  ) success ON success.table_id = status.table_id
+		  ORDER BY tables.TABLE_SCHEMA, tables.TABLE_NAME, PARTITION_NAME
+		`
+
+// States of the current TTL job of a table, idle when there is none.
+var ttlJobStates = []string{"idle", "waiting", "running", "cancelling"}
+
+// Metric descriptors.
+var (
+	ttlJobStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "job_state"),
+		"The state of the current TTL job of a table or partition, 1 for the current state and 0 for the others.",
+		[]string{"schema", "table", "partition", "state"}, nil)
+	ttlLastSuccessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "last_success_timestamp_seconds"),
+		"The time the last finished TTL job of a table or partition ended.",
+		[]string{"schema", "table", "partition"}, nil)
+	ttlLastJobScannedRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "last_job_scanned_rows"),
+		"The number of expired rows found by the scan of the last TTL job of a table or partition.",
+		[]string{"schema", "table", "partition"}, nil)
+	ttlLastJobDeletedRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "last_job_deleted_rows"),
+		"The number of rows deleted by the last TTL job of a table or partition.",
+		[]string{"schema", "table", "partition"}, nil)
+	ttlLastJobErrorRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "last_job_error_rows"),
+		"The number of rows the last TTL job of a table or partition failed to delete.",
+		[]string{"schema", "table", "partition"}, nil)
+	ttlExpireLagDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, ttl, "expire_lag_seconds"),
+		"How far the expiration time of the latest TTL job of a table or partition is behind the time of the server. It keeps growing past the TTL of the table once its cleanup stops.",
+		[]string{"schema", "table", "partition"}, nil)
+)
+
+// ScrapeTiDBTTL collects from `mysql.tidb_ttl_table_status` and `mysql.tidb_ttl_job_history`.
+type ScrapeTiDBTTL struct{}
+
+// Name of the Scraper. Should be unique.
+func (ScrapeTiDBTTL) Name() string {
+	return mysql + ".tidb_ttl"
+}
+
+// Help describes the role of the Scraper.
+func (ScrapeTiDBTTL) Help() string {
+	return "Collect the state and the last jobs of TTL tables from mysql.tidb_ttl_table_status and mysql.tidb_ttl_job_history"
+}
+
+// Version of MySQL from which scraper is available.
+func (ScrapeTiDBTTL) Version() float64 {
+	return 5.7
+}
+
+// Scrape collects data from database connection and sends it over channel as prometheus metric.
+func (ScrapeTiDBTTL) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error {
+	ttlRows, err := db.QueryContext(ctx, mysqlTiDBTTLQuery)
+	if err != nil {
+		return err
+	}
+	defer ttlRows.Close()
+
+	var (
+		database    string
+		table       string
+		partition   string
+		state       string
+		lastSuccess sql.NullFloat64
+		scanned     sql.NullFloat64
+		deleted     sql.NullFloat64
+		errorRows   sql.NullFloat64
+		expireLag   sql.NullFloat64
+	)
+	for ttlRows.Next() {
+		if err := ttlRows.Scan(&database, &table, &partition, &state, &lastSuccess, &scanned, &deleted, &errorRows, &expireLag); err != nil {
+			return err
+		}
+		if state == "" {
+			state = "idle"
+		}
+
+		known := false
+		for _, s := range ttlJobStates {
+			value := 0.0
+			if s == state {
+				value, known = 1, true
+			}
+			ch <- prometheus.MustNewConstMetric(ttlJobStateDesc, prometheus.GaugeValue, value, database, table, partition, s)
+		}
+		if !known {
+			ch <- prometheus.MustNewConstMetric(ttlJobStateDesc, prometheus.GaugeValue, 1, database, table, partition, state)
+		}
+
+		// Tables without a finished job, or whose job history has been
+		// cleaned up, have no values to report.
+		if lastSuccess.Valid {
+			ch <- prometheus.MustNewConstMetric(ttlLastSuccessDesc, prometheus.GaugeValue, lastSuccess.Float64, database, table, partition)
+		}
+		if scanned.Valid {
+			ch <- prometheus.MustNewConstMetric(ttlLastJobScannedRowsDesc, prometheus.GaugeValue, scanned.Float64, database, table, partition)
+		}
+		if deleted.Valid {
+			ch <- prometheus.MustNewConstMetric(ttlLastJobDeletedRowsDesc, prometheus.GaugeValue, deleted.Float64, database, table, partition)
+		}
+		if errorRows.Valid {
+			ch <- prometheus.MustNewConstMetric(ttlLastJobErrorRowsDesc, prometheus.GaugeValue, errorRows.Float64, database, table, partition)
+		}
+		if expireLag.Valid {
+			ch <- prometheus.MustNewConstMetric(ttlExpireLagDesc, prometheus.GaugeValue, expireLag.Float64, database, table, partition)
+		}
+	}
+	return ttlRows.Err()
+}
+
+// check interface
+var _ Scraper = ScrapeTiDBTTL{}
@@ -0,0 +1,90 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScrapeTiDBTTL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %s", err)
+	}
+	defer db.Close()
+
+	columns := []string{"TABLE_SCHEMA", "TABLE_NAME", "PARTITION_NAME", "CURRENT_JOB_STATUS", "LAST_SUCCESS_TIME",
+		"expired_rows", "deleted_rows", "error_delete_rows", "EXPIRE_LAG"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("app", "events", "p0", "running", 1792112400, 1200, 1195, 5, 86460).
+		AddRow("app", "sessions", "", "", nil, nil, nil, nil, nil).
+		AddRow("app", "tokens", "", "paused", 1792026000, 0, 0, 0, 3600)
+	mock.ExpectQuery(sanitizeQuery(mysqlTiDBTTLQuery)).WillReturnRows(rows)
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		if err = (ScrapeTiDBTTL{}).Scrape(context.Background(), db, ch, log.NewNopLogger()); err != nil {
+			t.Errorf("error calling function on test: %s", err)
+		}
+		close(ch)
+	}()
+
+	events := labelMap{"schema": "app", "table": "events", "partition": "p0"}
+	tokens := labelMap{"schema": "app", "table": "tokens", "partition": ""}
+	expected := []MetricResult{
+		{labels: labelMap{"schema": "app", "table": "events", "partition": "p0", "state": "idle"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "events", "partition": "p0", "state": "waiting"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "events", "partition": "p0", "state": "running"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "events", "partition": "p0", "state": "cancelling"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 1792112400, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 1200, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 1195, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 5, metricType: dto.MetricType_GAUGE},
+		{labels: events, value: 86460, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "sessions", "partition": "", "state": "idle"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "sessions", "partition": "", "state": "waiting"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "sessions", "partition": "", "state": "running"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "sessions", "partition": "", "state": "cancelling"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "tokens", "partition": "", "state": "idle"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "tokens", "partition": "", "state": "waiting"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "tokens", "partition": "", "state": "running"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "tokens", "partition": "", "state": "cancelling"}, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: labelMap{"schema": "app", "table": "tokens", "partition": "", "state": "paused"}, value: 1, metricType: dto.MetricType_GAUGE},
+		{labels: tokens, value: 1792026000, metricType: dto.MetricType_GAUGE},
+		{labels: tokens, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: tokens, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: tokens, value: 0, metricType: dto.MetricType_GAUGE},
+		{labels: tokens, value: 3600, metricType: dto.MetricType_GAUGE},
+	}
+	convey.Convey("Metrics comparison", t, func() {
+		for _, expect := range expected {
+			got := readMetric(<-ch)
+			convey.So(expect, convey.ShouldResemble, got)
+		}
+		_, ok := <-ch
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	// Ensure all SQL queries were executed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled exceptations: %s", err)
+	}
+}
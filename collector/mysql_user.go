@@ -18,6 +18,7 @@ package collector
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -66,6 +67,14 @@ const mysqlUserQuery = `
 		  FROM mysql.user
 		`
 
+const mysqlUserAttributesQuery = `
+		  SELECT
+		    user,
+		    host,
+		    ifnull(User_attributes, '') as User_attributes
+		  FROM mysql.user
+		`
+
 // Tunable flags.
 var (
 	userPrivilegesFlag = kingpin.Flag(
@@ -250,3 +259,42 @@ func (ScrapeUser) Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.M
 
 	return nil
 }
+
+// userAttributes is the part of the User_attributes JSON of mysql.user which is collected.
+type userAttributes struct {
+	ResourceGroup string `json:"resource_group"`
+}
+
+// userResourceGroups returns the number of users bound to each resource group.
+// Users without a resource group are bound to the default group.
+func userResourceGroups(ctx context.Context, db *sql.DB) (map[string]float64, error) {
+	userRows, err := db.QueryContext(ctx, mysqlUserAttributesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer userRows.Close()
+
+	var (
+		user       string
+		host       string
+		attributes string
+	)
+	groups := make(map[string]float64)
+	for userRows.Next() {
+		if err := userRows.Scan(&user, &host, &attributes); err != nil {
+			return nil, err
+		}
+		var parsed userAttributes
+		if attributes != "" {
+			if err := json.Unmarshal([]byte(attributes), &parsed); err != nil { // Silently skip unparsable values.
+				continue
+			}
+		}
+		group := strings.ToLower(parsed.ResourceGroup)
+		if group == "" {
+			group = "default"
+		}
+		groups[group]++
+	}
+	return groups, userRows.Err()
+}
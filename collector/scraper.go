@@ -37,3 +37,12 @@ type Scraper interface {
 	// Scrape collects data from database connection and sends it over channel as prometheus metric.
 	Scrape(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, logger log.Logger) error
 }
+
+// StatefulScraper is a Scraper which needs data kept between scrapes of the same target,
+// e.g. to turn values that reset periodically into counters.
+type StatefulScraper interface {
+	Scraper
+
+	// ScrapeWithState is called instead of Scrape with the state kept for the scraped target.
+	ScrapeWithState(ctx context.Context, db *sql.DB, ch chan<- prometheus.Metric, state *TargetState, logger log.Logger) error
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"sync"
+	"time"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Tunable flags.
+var (
+	stateTargetExpiry = kingpin.Flag(
+		"exporter.target_state_expiry",
+		"Drop the state kept for a target that has not been scraped for this long, 0 keeps it forever.",
+	).Default("1h").Duration()
+)
+
+// State keeps data between scrapes for scrapers implementing StatefulScraper.
+// Data is kept separately for each scraped target.
+type State struct {
+	mu      sync.Mutex
+	expiry  time.Duration
+	targets map[string]*TargetState
+}
+
+// NewState creates new State instance.
+func NewState() *State {
+	return &State{
+		expiry:  *stateTargetExpiry,
+		targets: make(map[string]*TargetState),
+	}
+}
+
+// Target returns the state of a target, creating it on first use.
+// The state of targets not scraped within the expiry is dropped, so probing
+// many targets over time does not keep all of them in memory.
+func (s *State) Target(target string) *TargetState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.expiry > 0 {
+		for name, t := range s.targets {
+			if now.Sub(t.lastScrape) > s.expiry {
+				delete(s.targets, name)
+			}
+		}
+	}
+
+	t, ok := s.targets[target]
+	if !ok {
+		t = &TargetState{values: make(map[string]interface{})}
+		s.targets[target] = t
+	}
+	t.lastScrape = now
+	return t
+}
+
+// TargetState keeps data between scrapes of one target, separately for each scraper.
+type TargetState struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+
+	// Guarded by the mutex of State.
+	lastScrape time.Time
+}
+
+// Load returns the value kept for a scraper, creating it with newValue on first use.
+// Values are shared by concurrent scrapes of the same target and must do their own locking.
+func (t *TargetState) Load(scraper string, newValue func() interface{}) interface{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	v, ok := t.values[scraper]
+	if !ok {
+		v = newValue()
+		t.values[scraper] = v
+	}
+	return v
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestStateTargetExpiry(t *testing.T) {
+	convey.Convey("Target state expiry", t, func() {
+		state := NewState()
+		state.expiry = time.Hour
+
+		stale := state.Target("stale")
+		fresh := state.Target("fresh")
+		stale.lastScrape = time.Now().Add(-2 * time.Hour)
+
+		convey.So(state.Target("fresh"), convey.ShouldEqual, fresh)
+		convey.So(state.targets, convey.ShouldNotContainKey, "stale")
+		convey.So(state.Target("stale"), convey.ShouldNotEqual, stale)
+
+		convey.Convey("Zero expiry keeps targets", func() {
+			state.expiry = 0
+			kept := state.Target("kept")
+			kept.lastScrape = time.Now().Add(-24 * time.Hour)
+			convey.So(state.Target("fresh"), convey.ShouldEqual, fresh)
+			convey.So(state.Target("kept"), convey.ShouldEqual, kept)
+		})
+	})
+}
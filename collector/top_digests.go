@@ -0,0 +1,105 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"sort"
+	"time"
+)
+
+// topDigests bounds the digests counted separately to the ones seen most often recently.
+// Digests are ranked by how often they were seen in the current and the previous window,
+// so a digest which stops being seen is replaced by the current heavy hitters.
+type topDigests struct {
+	window   time.Duration
+	rotated  time.Time
+	current  map[string]int
+	previous map[string]int
+	tracked  map[string]bool
+}
+
+func newTopDigests() *topDigests {
+	return &topDigests{
+		current:  make(map[string]int),
+		previous: make(map[string]int),
+		tracked:  make(map[string]bool),
+	}
+}
+
+// observe counts a digest and returns the digest to count it with, an empty digest if it is not tracked.
+// While fewer than limit digests are tracked, new digests are tracked right away.
+func (t *topDigests) observe(digest string, limit int) string {
+	t.current[digest]++
+	if !t.tracked[digest] && len(t.tracked) < limit {
+		t.tracked[digest] = true
+	}
+	if !t.tracked[digest] {
+		return ""
+	}
+	return digest
+}
+
+// update ranks the digests, rotating the windows when the current one is older than window.
+// It returns the digests which are no longer tracked, their counters are to be deleted.
+func (t *topDigests) update(now time.Time, window time.Duration, limit int) []string {
+	if t.rotated.IsZero() {
+		t.rotated = now
+	}
+	if elapsed := now.Sub(t.rotated); elapsed >= window {
+		t.previous, t.current = t.current, make(map[string]int)
+		if elapsed >= 2*window {
+			t.previous = make(map[string]int)
+		}
+		t.rotated = now
+	}
+
+	counts := make(map[string]int, len(t.current)+len(t.previous))
+	for digest, n := range t.previous {
+		counts[digest] += n
+	}
+	for digest, n := range t.current {
+		counts[digest] += n
+	}
+	ranked := make([]string, 0, len(counts))
+	for digest := range counts {
+		ranked = append(ranked, digest)
+	}
+	// Tracked digests win ties, so equally frequent digests do not take turns.
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		if t.tracked[a] != t.tracked[b] {
+			return t.tracked[a]
+		}
+		return a < b
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	tracked := make(map[string]bool, len(ranked))
+	for _, digest := range ranked {
+		tracked[digest] = true
+	}
+	var dropped []string
+	for _, digest := range sortedMapKeys(t.tracked) {
+		if !tracked[digest] {
+			dropped = append(dropped, digest)
+		}
+	}
+	t.tracked = tracked
+	return dropped
+}
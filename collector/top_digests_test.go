@@ -0,0 +1,46 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTopDigests(t *testing.T) {
+	convey.Convey("Top digests follow the recent heavy hitters", t, func() {
+		digests := newTopDigests()
+		start := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+
+		convey.So(digests.observe("aaa", 1), convey.ShouldEqual, "aaa")
+		convey.So(digests.observe("bbb", 1), convey.ShouldEqual, "")
+		convey.So(digests.observe("aaa", 1), convey.ShouldEqual, "aaa")
+		convey.So(digests.update(start, time.Hour, 1), convey.ShouldBeEmpty)
+
+		// Counts of the previous window still rank the digests after a rotation.
+		convey.So(digests.update(start.Add(time.Hour), time.Hour, 1), convey.ShouldBeEmpty)
+		for i := 0; i < 3; i++ {
+			convey.So(digests.observe("bbb", 1), convey.ShouldEqual, "")
+		}
+		convey.So(digests.update(start.Add(time.Hour+time.Minute), time.Hour, 1), convey.ShouldResemble, []string{"aaa"})
+		convey.So(digests.observe("bbb", 1), convey.ShouldEqual, "bbb")
+		convey.So(digests.observe("aaa", 1), convey.ShouldEqual, "")
+
+		// Digests not seen for two windows are no longer tracked.
+		convey.So(digests.update(start.Add(4*time.Hour), time.Hour, 1), convey.ShouldResemble, []string{"bbb"})
+		convey.So(digests.observe("ccc", 1), convey.ShouldEqual, "ccc")
+	})
+}
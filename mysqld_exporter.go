@@ -69,10 +69,34 @@ var (
 
 // scrapers lists all possible collection methods and if they should be enabled by default.
 var scrapers = map[collector.Scraper]bool{
-	collector.ScrapeGlobalStatus{}:    true,
-	collector.ScrapeGlobalVariables{}: true,
-	collector.ScrapeProcesslist{}:     true,
-	collector.ScrapeTableSchema{}:     false,
+	collector.ScrapeGlobalStatus{}:      true,
+	collector.ScrapeGlobalVariables{}:   true,
+	collector.ScrapeProcesslist{}:       true,
+	collector.ScrapeTableSchema{}:       false,
+	collector.ScrapeClusterInfo{}:       true,
+	collector.ScrapeClusterLoad{}:       false,
+	collector.ScrapeClusterHardware{}:   false,
+	collector.ScrapeClusterConfig{}:     false,
+	collector.ScrapeStatementsSummary{}: false,
+	collector.ScrapeSlowQuery{}:         false,
+	collector.ScrapeTiKVRegionStatus{}:  false,
+	collector.ScrapeTiKVStoreStatus{}:   false,
+	collector.ScrapeHotRegions{}:        false,
+	collector.ScrapeDDLJobs{}:           false,
+	collector.ScrapeLockContention{}:    false,
+	collector.ScrapeTiDBTrx{}:           false,
+	collector.ScrapeTiFlashReplica{}:    false,
+	collector.ScrapeStatsHealth{}:       false,
+	collector.ScrapeResourceGroups{}:    false,
+	collector.ScrapePlacement{}:         false,
+	collector.ScrapeInspectionResult{}:  false,
+	collector.ScrapeGC{}:                false,
+	collector.ScrapeGCDeleteRange{}:     false,
+	collector.ScrapeIndexUsage{}:        false,
+	collector.ScrapeMemoryUsage{}:       false,
+	collector.ScrapeBinlogStatus{}:      false,
+	collector.ScrapeDataJobs{}:          false,
+	collector.ScrapeTiDBTTL{}:           false,
 }
 
 func filterScrapers(scrapers []collector.Scraper, collectParams []string) []collector.Scraper {
@@ -98,7 +122,7 @@ func init() {
 	prometheus.MustRegister(version.NewCollector("mysqld_exporter"))
 }
 
-func newHandler(metrics collector.Metrics, scrapers []collector.Scraper, logger log.Logger) http.HandlerFunc {
+func newHandler(metrics collector.Metrics, state *collector.State, scrapers []collector.Scraper, logger log.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var dsn string
 		var err error
@@ -142,7 +166,7 @@ func newHandler(metrics collector.Metrics, scrapers []collector.Scraper, logger
 
 		registry := prometheus.NewRegistry()
 
-		registry.MustRegister(collector.New(ctx, dsn, metrics, filteredScrapers, logger))
+		registry.MustRegister(collector.New(ctx, dsn, metrics, state, filteredScrapers, logger))
 
 		gatherers := prometheus.Gatherers{
 			prometheus.DefaultGatherer,
@@ -207,12 +231,14 @@ func main() {
 			enabledScrapers = append(enabledScrapers, scraper)
 		}
 	}
-	handlerFunc := newHandler(collector.NewMetrics(), enabledScrapers, logger)
+	// State of stateful scrapers is shared by the metrics and probe handlers, separately per target.
+	state := collector.NewState()
+	handlerFunc := newHandler(collector.NewMetrics(), state, enabledScrapers, logger)
 	http.Handle(*metricPath, promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer, handlerFunc))
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write(landingPage)
 	})
-	http.HandleFunc("/probe", handleProbe(collector.NewMetrics(), enabledScrapers, logger))
+	http.HandleFunc("/probe", handleProbe(collector.NewMetrics(), state, enabledScrapers, logger))
 
 	srv := &http.Server{}
 	if err := web.ListenAndServe(srv, toolkitFlags, logger); err != nil {
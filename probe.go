@@ -24,7 +24,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func handleProbe(metrics collector.Metrics, scrapers []collector.Scraper, logger log.Logger) http.HandlerFunc {
+func handleProbe(metrics collector.Metrics, state *collector.State, scrapers []collector.Scraper, logger log.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var dsn, authModule string
 		var err error
@@ -62,7 +62,7 @@ func handleProbe(metrics collector.Metrics, scrapers []collector.Scraper, logger
 
 		registry := prometheus.NewRegistry()
 		registry.MustRegister(probeSuccessGauge)
-		registry.MustRegister(collector.New(ctx, dsn, metrics, filteredScrapers, logger))
+		registry.MustRegister(collector.New(ctx, dsn, metrics, state, filteredScrapers, logger))
 
 		if err != nil {
 			probeSuccessGauge.Set(1)